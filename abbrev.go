@@ -0,0 +1,79 @@
+/*
+   Szerszam argument library: szargs.
+   Copyright (C) 2024-2025  Leslie Dancsecs
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU General Public License for more details.
+
+   You should have received a copy of the GNU General Public License
+   along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package szargs
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// SetAbbreviations enables or disables the resolution of unambiguous long
+// flag prefixes (IE: "--verb" for "--verbose").  Prefixes are resolved
+// against all long flag names registered.  A prefix already resolved that
+// becomes ambiguous when a further flag is registered registers an
+// ErrAmbiguous error (see RegisterUsage).  Disabled by default.
+func (args *Args) SetAbbreviations(enabled bool) {
+	args.abbreviate = enabled
+}
+
+// expandAbbrev resolves a long flag prefix to the single long flag name in
+// the registry it abbreviates.  Arguments that are not long flags, are
+// already registered or match no registered name are returned unchanged.  If
+// the prefix matches more than one name an ErrAmbiguous error is returned.
+func (args *Args) expandAbbrev(arg string, reg registry) (string, error) {
+	if !strings.HasPrefix(arg, "--") || arg == endOfOptions {
+		return arg, nil
+	}
+
+	name, value, hasValue := strings.Cut(arg, "=")
+
+	if _, known := reg[name]; known {
+		return arg, nil
+	}
+
+	var candidates []string
+
+	for registered := range reg {
+		if strings.HasPrefix(registered, name) &&
+			strings.HasPrefix(registered, "--") {
+			candidates = append(candidates, registered)
+		}
+	}
+
+	switch len(candidates) {
+	case 0:
+		return arg, nil
+	case 1:
+		if hasValue {
+			return candidates[0] + "=" + value, nil
+		}
+
+		return candidates[0], nil
+	default:
+		slices.Sort(candidates)
+
+		return "", fmt.Errorf(
+			"%w: '%s' could be any of %v",
+			ErrAmbiguous,
+			name,
+			candidates,
+		)
+	}
+}
@@ -0,0 +1,135 @@
+/*
+   Szerszam argument library: szargs.
+   Copyright (C) 2024-2025  Leslie Dancsecs
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU General Public License for more details.
+
+   You should have received a copy of the GNU General Public License
+   along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package szargs_test
+
+import (
+	"testing"
+
+	"github.com/dancsecs/szargs"
+	"github.com/dancsecs/sztestlog"
+)
+
+func TestSzargs_Abbreviations_Disabled(t *testing.T) {
+	chk := sztestlog.CaptureNothing(t)
+	defer chk.Release()
+
+	args := szargs.New("program description", []string{
+		"programName",
+		"--verb",
+	})
+
+	chk.Int(args.Count("[-v | --verbose ...]", "verbose level"), 0)
+
+	args.Done()
+
+	chk.Err(
+		args.Err(),
+		chk.ErrChain(
+			szargs.ErrUnexpected,
+			"[--verb]",
+		),
+	)
+}
+
+func TestSzargs_Abbreviations_Unique(t *testing.T) {
+	chk := sztestlog.CaptureNothing(t)
+	defer chk.Release()
+
+	args := szargs.New("program description", []string{
+		"programName",
+		"--verb",
+		"--num", "5",
+		"--verbose",
+		"--na=bob",
+		"file",
+	})
+
+	args.SetAbbreviations(true)
+	args.RegisterUsage("[-n | --number num]", "a number")
+	args.RegisterUsage("[--name name]", "a name")
+
+	chk.Int(args.Count("[-v | --verbose ...]", "verbose level"), 2)
+
+	num, found := args.ValueInt("[-n | --number num]", "a number")
+	chk.True(found)
+	chk.Int(num, 5)
+
+	name, found := args.ValueString("[--name name]", "a name")
+	chk.True(found)
+	chk.Str(name, "bob")
+
+	chk.Str(args.NextString("file", "a file"), "file")
+
+	args.Done()
+
+	chk.NoErr(args.Err())
+}
+
+func TestSzargs_Abbreviations_Ambiguous(t *testing.T) {
+	chk := sztestlog.CaptureNothing(t)
+	defer chk.Release()
+
+	args := szargs.New("program description", []string{
+		"programName",
+		"--n=5",
+	})
+
+	args.SetAbbreviations(true)
+	args.RegisterUsage("[-n | --number num]", "a number")
+	args.RegisterUsage("[--name name]", "a name")
+
+	num, found := args.ValueInt("[-n | --number num]", "a number")
+	chk.False(found)
+	chk.Int(num, 0)
+
+	args.Done()
+
+	chk.Err(
+		args.Err(),
+		chk.ErrChain(
+			szargs.ErrAmbiguous,
+			"'--n' could be any of [--name --number]",
+		),
+	)
+}
+
+func TestSzargs_Abbreviations_AmbiguousLate(t *testing.T) {
+	chk := sztestlog.CaptureNothing(t)
+	defer chk.Release()
+
+	args := szargs.New("program description", []string{
+		"programName",
+		"--verb",
+	})
+
+	args.SetAbbreviations(true)
+
+	chk.True(args.Is("[--verbose]", "verbose output"))
+	chk.False(args.Is("[--verbatim]", "verbatim output"))
+
+	args.Done()
+
+	chk.Err(
+		args.Err(),
+		chk.ErrChain(
+			szargs.ErrAmbiguous,
+			"'--verb' could be any of [--verbatim --verbose]",
+		),
+	)
+}
@@ -0,0 +1,188 @@
+/*
+   Szerszam argument library: szargs.
+   Copyright (C) 2024-2025  Leslie Dancsecs
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU General Public License for more details.
+
+   You should have received a copy of the GNU General Public License
+   along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package szargs
+
+import (
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+)
+
+// aliasPrefix starts the name of an alias defined in configuration text.
+const aliasPrefix = "alias."
+
+// maxAliasDepth limits how many aliases may be expanded in turn.
+const maxAliasDepth = 16
+
+// ParseAliases reads alias definitions from configuration text (IE: the
+// contents of a configuration file or environment variable).  Each non blank
+// line not starting with '#' or ';' defines an alias as follows:
+//
+//	alias.ship = deploy --env prod --confirm
+//
+// A line not defining an alias returns an ErrInvalidAlias error identifying
+// the line.
+func ParseAliases(text string) (map[string]string, error) {
+	aliases := make(map[string]string)
+
+	for i, line := range strings.Split(text, "\n") {
+		line = strings.TrimSpace(line)
+
+		if line == "" || line[0] == '#' || line[0] == ';' {
+			continue
+		}
+
+		key, expansion, ok := strings.Cut(line, "=")
+		name, isAlias := strings.CutPrefix(strings.TrimSpace(key), aliasPrefix)
+
+		if !ok || !isAlias || name == "" ||
+			strings.ContainsAny(name, " \t") {
+			return nil, fmt.Errorf(
+				"%w: line %d: '%s'", ErrInvalidAlias, i+1, line,
+			)
+		}
+
+		aliases[name] = strings.TrimSpace(expansion)
+	}
+
+	return aliases, nil
+}
+
+// ExpandAliases replaces the first positional argument with the arguments of
+// the alias it names (IE: "ship" with "deploy --env prod --confirm").  The
+// expansion is split using the same shell-like quoting rules as response
+// files.  If the expansion's first positional argument names another alias
+// it is expanded in turn up to a depth of 16.  An expansion without a
+// positional argument leaves the following argument first and it is expanded
+// as a new chain.  The aliases are listed in their own section of the usage
+// message.
+//
+// It should be called immediately after New before any arguments are
+// extracted.  A flag preceding the first positional argument is only known
+// to take a value if already registered (see RegisterUsage).  Aliases
+// expanding to themselves (directly or indirectly), expansions too deep and
+// syntax errors register an error.
+func (args *Args) ExpandAliases(aliases map[string]string) {
+	args.aliases = maps.Clone(aliases)
+
+	var seen []string
+
+	end := 0 // End of the previous expansion.
+
+	for idx := args.firstPositional(0); idx >= 0; {
+		name := args.args[idx]
+
+		expansion, ok := aliases[name]
+		if !ok {
+			return
+		}
+
+		if idx >= end {
+			// Not from the previous expansion so begins a new chain.
+			seen = nil
+		}
+
+		switch {
+		case slices.Contains(seen, name):
+			args.PushErr(
+				fmt.Errorf(
+					"%w: cycle expanding '%s': %s",
+					ErrInvalidAlias,
+					name,
+					strings.Join(append(seen, name), " -> "),
+				),
+			)
+
+			return
+		case len(seen) >= maxAliasDepth:
+			args.PushErr(
+				fmt.Errorf(
+					"%w: '%s' nested deeper than %d aliases",
+					ErrInvalidAlias,
+					name,
+					maxAliasDepth,
+				),
+			)
+
+			return
+		}
+
+		words, _, err := splitShell(expansion)
+		if err != nil {
+			args.PushErr(
+				fmt.Errorf("%w: '%s': %w", ErrInvalidAlias, name, err),
+			)
+
+			return
+		}
+
+		expanded := make([]string, len(words))
+		for i, word := range words {
+			expanded[i] = word.text
+		}
+
+		seen = append(seen, name)
+		args.setArgs(
+			slices.Concat(args.args[:idx], expanded, args.args[idx+1:]),
+			slices.Concat(
+				args.origins[:idx],
+				args.newOrigins(len(expanded), args.origins[idx]),
+				args.origins[idx+1:],
+			),
+		)
+		end = idx + len(expanded)
+		idx = args.firstPositional(idx)
+	}
+}
+
+// firstPositional returns the index of the first positional argument at or
+// following the start index or -1 if there is none.  The values of
+// registered flags are skipped.
+func (args *Args) firstPositional(start int) int {
+	for i := start; i < len(args.args); i++ {
+		arg := args.args[i]
+
+		switch {
+		case arg == endOfOptions:
+			return -1
+		case isFlagName(arg):
+			i += max(args.flags[arg], 0)
+		default:
+			return i
+		}
+	}
+
+	return -1
+}
+
+// aliasUsage returns the usage section listing the aliases.
+func (args *Args) aliasUsage() string {
+	if len(args.aliases) == 0 {
+		return ""
+	}
+
+	section := "\n\naliases:"
+
+	for _, name := range slices.Sorted(maps.Keys(args.aliases)) {
+		section += "\n    " + name + " = " + args.aliases[name]
+	}
+
+	return section
+}
@@ -0,0 +1,201 @@
+/*
+   Szerszam argument library: szargs.
+   Copyright (C) 2024-2025  Leslie Dancsecs
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU General Public License for more details.
+
+   You should have received a copy of the GNU General Public License
+   along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package szargs_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/dancsecs/szargs"
+	"github.com/dancsecs/sztestlog"
+)
+
+const tstAliasConfig = `
+# Deployment aliases.
+alias.ship = deploy --env prod --confirm
+alias.go   = ship --quiet 'final notes'
+; Unused.
+alias.loop = loop
+`
+
+func TestSzargs_ParseAliases(t *testing.T) {
+	chk := sztestlog.CaptureNothing(t)
+	defer chk.Release()
+
+	aliases, err := szargs.ParseAliases(tstAliasConfig)
+
+	chk.NoErr(err)
+	chk.Int(len(aliases), 3)
+	chk.Str(aliases["ship"], "deploy --env prod --confirm")
+	chk.Str(aliases["go"], "ship --quiet 'final notes'")
+	chk.Str(aliases["loop"], "loop")
+
+	aliases, err = szargs.ParseAliases("alias.ok = fine\nship = deploy")
+
+	chk.Err(
+		err,
+		chk.ErrChain(szargs.ErrInvalidAlias, "line 2: 'ship = deploy'"),
+	)
+	chk.Int(len(aliases), 0)
+}
+
+func TestSzargs_ExpandAliases(t *testing.T) {
+	chk := sztestlog.CaptureNothing(t)
+	defer chk.Release()
+
+	aliases, err := szargs.ParseAliases(tstAliasConfig)
+	chk.NoErr(err)
+
+	args := szargs.New("program description", []string{
+		"programName",
+		"-C", "dir",
+		"go",
+		"extra",
+	})
+
+	args.RegisterUsage("[-C dir]", "the directory")
+	args.ExpandAliases(aliases)
+
+	chk.NoErr(args.Err())
+	chk.StrSlice(args.Args(), []string{
+		"-C", "dir",
+		"deploy", "--env", "prod", "--confirm",
+		"--quiet", "final notes",
+		"extra",
+	})
+
+	chk.StrSlice(
+		strings.Split(args.Usage(0), "\n"),
+		[]string{
+			"usage: programName [-C dir]",
+			"",
+			"program description",
+			"",
+			"    [-C dir]",
+			"        the directory",
+			"",
+			"aliases:",
+			"    go = ship --quiet 'final notes'",
+			"    loop = loop",
+			"    ship = deploy --env prod --confirm",
+		},
+	)
+}
+
+func TestSzargs_ExpandAliases_NotAlias(t *testing.T) {
+	chk := sztestlog.CaptureNothing(t)
+	defer chk.Release()
+
+	args := szargs.New("program description", []string{
+		"programName",
+		"--",
+		"ship",
+	})
+
+	args.ExpandAliases(map[string]string{"ship": "deploy"})
+
+	chk.NoErr(args.Err())
+	chk.StrSlice(args.Args(), []string{"--", "ship"})
+}
+
+func TestSzargs_ExpandAliases_Cycle(t *testing.T) {
+	chk := sztestlog.CaptureNothing(t)
+	defer chk.Release()
+
+	args := szargs.New("program description", []string{
+		"programName",
+		"a",
+	})
+
+	args.ExpandAliases(map[string]string{"a": "b -x", "b": "a -y"})
+
+	chk.Err(
+		args.Err(),
+		chk.ErrChain(
+			szargs.ErrInvalidAlias,
+			"cycle expanding 'a': a -> b -> a",
+		),
+	)
+	chk.StrSlice(args.Args(), []string{"a", "-y", "-x"})
+}
+
+func TestSzargs_ExpandAliases_Repeated(t *testing.T) {
+	chk := sztestlog.CaptureNothing(t)
+	defer chk.Release()
+
+	args := szargs.New("program description", []string{
+		"programName",
+		"v",
+		"v",
+	})
+
+	args.ExpandAliases(map[string]string{"v": "--verbose"})
+
+	chk.NoErr(args.Err())
+	chk.StrSlice(args.Args(), []string{"--verbose", "--verbose"})
+}
+
+func TestSzargs_ExpandAliases_Depth(t *testing.T) {
+	chk := sztestlog.CaptureNothing(t)
+	defer chk.Release()
+
+	aliases := make(map[string]string)
+
+	for i := range 20 {
+		aliases[string(rune('a'+i))] = string(rune('a' + i + 1))
+	}
+
+	args := szargs.New("program description", []string{
+		"programName",
+		"a",
+	})
+
+	args.ExpandAliases(aliases)
+
+	chk.Err(
+		args.Err(),
+		chk.ErrChain(
+			szargs.ErrInvalidAlias,
+			"'q' nested deeper than 16 aliases",
+		),
+	)
+}
+
+func TestSzargs_ExpandAliases_Syntax(t *testing.T) {
+	chk := sztestlog.CaptureNothing(t)
+	defer chk.Release()
+
+	args := szargs.New("program description", []string{
+		"programName",
+		"ship",
+	})
+
+	args.ExpandAliases(map[string]string{"ship": "deploy 'open"})
+
+	chk.Err(
+		args.Err(),
+		chk.ErrChain(
+			szargs.ErrInvalidAlias,
+			"'ship'",
+			szargs.ErrSyntax,
+			"unterminated quote (')",
+		),
+	)
+	chk.StrSlice(args.Args(), []string{"ship"})
+}
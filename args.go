@@ -19,74 +19,136 @@
 package szargs
 
 import (
+	"errors"
 	"fmt"
+	"os"
 	"path/filepath"
-	"regexp"
+	"slices"
 	"strings"
+	"time"
 )
 
 // Args provides a single point to access and extract program arguments.
 type Args struct {
-	usageDefined  map[string]bool
-	usageHeader   string
-	usageSynopsis []string
-	usageBody     string
-	lineWidth     int
-	programName   string
-	programDesc   string
-	args          []string
-	err           error
-}
-
-var reIsGroup = regexp.MustCompile(`^-[A-Za-z]+$`)
-
-func makeArgList(arg string) []string {
-	if reIsGroup.MatchString(arg) {
-		list := make([]string, 0, len(arg)-1)
-		for _, option := range arg[1:] {
-			list = append(list, "-"+string(option))
-		}
-
-		return list
-	}
-
-	return []string{arg}
+	usageDefined   map[string]bool
+	flags          registry
+	usageHeader    string
+	usageSynopsis  []string
+	usageBody      string
+	lineWidth      int
+	programName    string
+	programDesc    string
+	args           []string
+	origins        []origin
+	nextOrigin     int
+	sealed         *snapshot
+	extracted      []argFlag
+	terminated     bool
+	abbreviate     bool
+	listSeparator  string
+	posixlyCorrect bool
+	dialect        Dialect
+	repeatPolicy   RepeatPolicy
+	repeatPolicies map[string]RepeatPolicy
+	envArgsName    string
+	children       []*Args
+	stream         *argStream
+	aliases        map[string]string
+	durationUnit   time.Duration
+	timeLayouts    []string
+	timeLocation   *time.Location
+	err            error
 }
 
+// endOfOptions marks the end of flagged arguments.  Every argument following
+// it is treated as a positional argument.
+const endOfOptions = "--"
+
 // New creates a new Args object based in the arguments passed.  The first
 // element of the arguments must be the program name.
 func New(programDesc string, args []string) *Args {
 	if len(args) < 1 {
 		return &Args{
-			usageDefined:  make(map[string]bool),
-			usageHeader:   "",
-			usageSynopsis: nil,
-			usageBody:     "",
-			programName:   "NotDefined",
-			programDesc:   prepareDesc("", programDesc),
-			lineWidth:     defaultLineWidth,
-			args:          nil,
-			err:           ErrNoArgs,
+			usageDefined:   make(map[string]bool),
+			flags:          make(registry),
+			usageHeader:    "",
+			usageSynopsis:  nil,
+			usageBody:      "",
+			programName:    "NotDefined",
+			programDesc:    prepareDesc("", programDesc),
+			lineWidth:      defaultLineWidth,
+			args:           nil,
+			origins:        nil,
+			nextOrigin:     0,
+			sealed:         nil,
+			extracted:      nil,
+			terminated:     false,
+			abbreviate:     false,
+			listSeparator:  "",
+			posixlyCorrect: false,
+			dialect:        DialectGNU,
+			repeatPolicy:   RepeatError,
+			repeatPolicies: make(map[string]RepeatPolicy),
+			envArgsName:    "",
+			children:       nil,
+			stream:         nil,
+			aliases:        nil,
+			durationUnit:   0,
+			timeLayouts:    nil,
+			timeLocation:   nil,
+			err:            ErrNoArgs,
 		}
 	}
 
-	var myArgs []string
+	var (
+		myArgs  []string
+		origins []origin
+	)
+
+	if len(args) > 1 {
+		myArgs = make([]string, len(args)-1)
+		copy(myArgs, args[1:])
 
-	for _, arg := range args[1:] {
-		myArgs = append(myArgs, makeArgList(arg)...)
+		origins = make([]origin, len(myArgs))
+		for i := range origins {
+			origins[i] = origin{id: i, argv: i + 1, env: false}
+		}
 	}
 
-	return &Args{
-		usageDefined:  make(map[string]bool),
-		usageHeader:   "",
-		usageSynopsis: nil,
-		usageBody:     "",
-		programName:   filepath.Base(args[0]),
-		programDesc:   prepareDesc("", programDesc),
-		lineWidth:     defaultLineWidth,
-		args:          myArgs,
-		err:           nil,
+	_, posixlyCorrect := os.LookupEnv(posixlyCorrectEnv)
+
+	result := &Args{
+		usageDefined:   make(map[string]bool),
+		flags:          make(registry),
+		usageHeader:    "",
+		usageSynopsis:  nil,
+		usageBody:      "",
+		programName:    filepath.Base(args[0]),
+		programDesc:    prepareDesc("", programDesc),
+		lineWidth:      defaultLineWidth,
+		args:           myArgs,
+		origins:        origins,
+		nextOrigin:     len(origins),
+		sealed:         nil,
+		extracted:      nil,
+		terminated:     false,
+		abbreviate:     false,
+		listSeparator:  "",
+		posixlyCorrect: posixlyCorrect,
+		dialect:        DialectGNU,
+		repeatPolicy:   RepeatError,
+		repeatPolicies: make(map[string]RepeatPolicy),
+		envArgsName:    "",
+		children:       nil,
+		stream:         nil,
+		aliases:        nil,
+		durationUnit:   0,
+		timeLayouts:    nil,
+		timeLocation:   nil,
+		err:            nil,
 	}
+
+	return result
 }
 
 // PushErr registers the provided error if not nil to the Args error stack.
@@ -101,24 +163,33 @@ func (args *Args) PushErr(err error) {
 }
 
 // Err returns any errors encountered or registered while parsing the
-// arguments.
+// arguments followed by those of any segments split from them.
 func (args *Args) Err() error {
-	return args.err
+	return args.segmentErrs(args.err)
 }
 
 // HasErr returns true if any errors have been encountered or registered.
 func (args *Args) HasErr() bool {
-	return args.err != nil
+	return args.Err() != nil
 }
 
-// HasNext returns true if any arguments remain unabsorbed.
+// HasNext returns true if any arguments remain unabsorbed including any
+// arguments available from an argument stream (see SetArgStream).  A lone
+// remaining end of options marker ("--") is not considered an argument.
 func (args *Args) HasNext() bool {
-	return len(args.args) > 0
+	return args.streamHasNext() || len(args.positionalArgs()) > 0
 }
 
 // PushArg places the supplied argument to the end of the internal args list.
 func (args *Args) PushArg(arg string) {
-	args.args = append(args.args, arg)
+	from := args.newOrigins(1, origin{id: 0, argv: -1, env: false})
+
+	args.setArgs(append(args.args, arg), append(args.origins, from...))
+
+	if args.sealed != nil {
+		args.sealed.args = append(args.sealed.args, arg)
+		args.sealed.origins = append(args.sealed.origins, from...)
+	}
 }
 
 // Args returns a copy of the current argument list.
@@ -129,41 +200,79 @@ func (args *Args) Args() []string {
 	return cpy
 }
 
-// Count returns the number of times the flag appears.
+// Count returns the number of times the flag appears.  The negated form of a
+// negatable flag (IE: "--no-verbose" for "[--[no-]verbose ...]") resets the
+// count to zero.
 func (args *Args) Count(flag, desc string) int {
 	var count int
 
 	args.RegisterUsage(flag, desc)
 
-	count, args.args = argFlag(flag).count(args.args)
+	count = args.scanCount(flag)
 
 	return count
 }
 
-// Is returns true if the flag is present one and only one time.
+// Is returns true if the flag is present one and only one time.  A repeated
+// flag registers an error unless permitted by the repeat policy (see
+// SetRepeatPolicy).
+//
+// A negatable flag is declared by prefixing its long name with "[no-]" (IE:
+// "[--[no-]color]") which also accepts the negated form ("--no-color").  A
+// negatable flag may appear multiple times with the last one seen winning.
+// Is then returns true if the flag was last seen in its positive form and
+// false if it was last seen negated (or not at all).  Use Polarity to tell
+// a negated flag apart from an absent one.
 func (args *Args) Is(flag, desc string) bool {
-	var (
-		found bool
-		err   error
-	)
+	result, _ := args.Polarity(flag, desc)
 
+	return result
+}
+
+// Polarity returns the state of a boolean flag as Is does along with a second
+// boolean indicating if the flag was present at all.  This distinguishes a
+// negated flag (IE: "--no-color" for "[--[no-]color]") returning (false, true)
+// from an absent flag returning (false, false).
+func (args *Args) Polarity(flag, desc string) (bool, bool) {
 	args.RegisterUsage(flag, desc)
 
-	found, args.args, err = argFlag(flag).is(args.args)
+	result, found, err := args.scanPolarity(flag)
 	if err != nil {
 		args.PushErr(err)
 	}
 
-	return found
+	return result, found
 }
 
-// Done registers an error if there are any remaining arguments.
+// Done registers an error if there are any remaining arguments including
+// any still to be read from the argument stream (see SetArgStream).  Only
+// the next argument of the stream is read and reported.  An unused end of
+// options marker ("--") is not reported.
 func (args *Args) Done() {
-	if len(args.args) > 0 {
+	fromEnv, remaining := args.splitEnvArgs()
+
+	if len(remaining) > 0 {
 		args.PushErr(
 			fmt.Errorf("%w: [%v]",
 				ErrUnexpected,
-				strings.Join(args.args, " "),
+				strings.Join(remaining, " "),
+			),
+		)
+	} else if len(fromEnv) == 0 && args.streamHasNext() {
+		args.PushErr(
+			fmt.Errorf("%w: [%v] from argument stream",
+				ErrUnexpected,
+				args.stream.pending[0],
+			),
+		)
+	}
+
+	if len(fromEnv) > 0 {
+		args.PushErr(
+			fmt.Errorf("%w: [%v] from environment variable %s",
+				ErrUnexpected,
+				strings.Join(fromEnv, " "),
+				args.envArgsName,
 			),
 		)
 	}
@@ -173,3 +282,270 @@ func (args *Args) Done() {
 func (args *Args) ProgramName() string {
 	return args.programName
 }
+
+// markerIndex returns the index of the first unconsumed end of options
+// marker or -1 if there is none.
+func (args *Args) markerIndex() int {
+	idx := -1
+	if !args.terminated {
+		idx = slices.Index(args.args, endOfOptions)
+	}
+
+	if idx > 0 && args.posixlyCorrect &&
+		slices.ContainsFunc(args.args[:idx], func(arg string) bool {
+			return !isFlagName(arg)
+		}) {
+		idx = -1 // The marker follows the first positional argument.
+	}
+
+	return idx
+}
+
+// positionalArgs returns the remaining arguments with the first unconsumed
+// end of options marker removed.
+func (args *Args) positionalArgs() []string {
+	idx := args.markerIndex()
+
+	if idx < 0 {
+		return args.args
+	}
+
+	return slices.Concat(args.args[:idx], args.args[idx+1:])
+}
+
+// scanArgs registers the flag and tokenizes the argument list before
+// splitting it into the leading portion that may be scanned for flags and the
+// trailing portion (beginning with any end of options marker) that must be
+// left untouched.
+func (args *Args) scanArgs(flag string, values int) ([]string, []string) {
+	args.registerFlag(flag, values)
+	args.tokenize()
+	args.extracted = append(args.extracted, argFlag(flag))
+	limit := args.scanLimit()
+
+	return args.args[:limit], args.args[limit:]
+}
+
+// setScanned replaces the scanned portion of the argument list with the
+// cleaned arguments remaining once the flag's occurrences were removed.  The
+// origins are left as is if nothing was removed (IE: a setting in error
+// returns the arguments unchanged).  The origins of the last argument (IE:
+// the value) of each occurrence are returned.
+func (args *Args) setScanned(
+	flag string, values int, cleaned, tail []string,
+) []origin {
+	limit := len(args.args) - len(tail)
+	found := argFlag(flag).find(args.args[:limit], values, args.flags)
+
+	origins := make([]origin, len(found))
+	for i, occ := range found {
+		origins[i] = args.origins[occ.end-1]
+	}
+
+	kept := args.origins[:limit]
+	if len(cleaned) != limit {
+		kept = removeOccurrences(kept, found)
+	}
+
+	args.setArgs(
+		slices.Concat(cleaned, tail),
+		slices.Concat(kept, args.origins[limit:]),
+	)
+
+	return origins
+}
+
+func (args *Args) scanCount(flag string) int {
+	scanned, tail := args.scanArgs(flag, noValue)
+	count, cleanedArgs := argFlag(flag).count(scanned, args.flags)
+	args.setScanned(flag, noValue, cleanedArgs, tail)
+
+	return count
+}
+
+func (args *Args) scanPolarity(flag string) (bool, bool, error) {
+	scanned, tail := args.scanArgs(flag, noValue)
+	result, found, cleanedArgs, err := argFlag(flag).polarity(
+		scanned, args.flags, args.repeatPolicyFor(flag),
+	)
+	args.setScanned(flag, noValue, cleanedArgs, tail)
+
+	return result, found, err
+}
+
+func (args *Args) scanValue(flag string) (string, origin, bool, error) {
+	policy := args.repeatPolicyFor(flag)
+	scanned, tail := args.scanArgs(flag, singleValue)
+	value, found, cleanedArgs, err := argFlag(flag).value(
+		scanned, args.flags, policy,
+	)
+	origins := args.setScanned(flag, singleValue, cleanedArgs, tail)
+
+	return value, selectedOrigin(origins, policy), found, err
+}
+
+func (args *Args) scanOptional(
+	flag string,
+) (string, origin, bool, bool, error) {
+	policy := args.repeatPolicyFor(flag)
+	scanned, tail := args.scanArgs(flag, optionalValue)
+	value, hasValue, found, cleanedArgs, err := argFlag(flag).optional(
+		scanned, args.flags, policy,
+	)
+	origins := args.setScanned(flag, optionalValue, cleanedArgs, tail)
+
+	return value, selectedOrigin(origins, policy), hasValue, found, err
+}
+
+func (args *Args) scanTuple(flag string, n int) ([]string, origin, error) {
+	policy := args.repeatPolicyFor(flag)
+	n = max(n, singleValue)
+	scanned, tail := args.scanArgs(flag, n)
+	values, cleanedArgs, err := argFlag(flag).tuple(
+		scanned, n, args.flags, policy,
+	)
+	origins := args.setScanned(flag, n, cleanedArgs, tail)
+
+	return values, selectedOrigin(origins, policy), err
+}
+
+func (args *Args) scanValues(flag string) ([]string, []origin, error) {
+	scanned, tail := args.scanArgs(flag, singleValue)
+	values, cleanedArgs, err := argFlag(flag).values(scanned, args.flags)
+	origins := args.setScanned(flag, singleValue, cleanedArgs, tail)
+
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return args.splitValues(flag, values, origins)
+}
+
+func (args *Args) scanSetting(
+	flag, env, def string,
+) (string, origin, error, error) {
+	policy := args.repeatPolicyFor(flag)
+	scanned, tail := args.scanArgs(flag, singleValue)
+	value, cleanedArgs, srcErr, err := setting(
+		flag, env, def, scanned, args.flags, policy,
+	)
+	origins := args.setScanned(flag, singleValue, cleanedArgs, tail)
+
+	if !errors.Is(srcErr, ErrInvalidFlag) {
+		origins = nil
+	}
+
+	return value, selectedOrigin(origins, policy), srcErr, err
+}
+
+// nextArg removes the next positional argument.  An end of options marker
+// ("--") at the front of the list is consumed first.  The argument is read
+// from the argument stream if it takes the place of the next argument.
+func (args *Args) nextArg(name string) (string, origin, error) {
+	args.tokenize()
+
+	if !args.terminated && len(args.args) > 0 &&
+		args.args[0] == endOfOptions {
+		args.dropArg(0)
+		args.terminated = true
+	}
+
+	var (
+		from = origin{id: 0, argv: -1, env: false}
+		err  error
+	)
+
+	result, fromStream := args.streamArg()
+	if !fromStream {
+		var newArgs []string
+
+		result, newArgs, err = next(name, args.args)
+
+		if err == nil {
+			from = args.origins[0]
+		}
+
+		args.setArgs(newArgs, args.origins[len(args.origins)-len(newArgs):])
+	}
+
+	if err == nil && args.posixlyCorrect && !isFlagName(result) {
+		// No flags follow the first positional argument.
+		args.terminated = true
+	}
+
+	return result, from, err
+}
+
+// lastArg removes the last positional argument.  The argument is read from
+// the argument stream if the stream takes the place of the last argument.
+func (args *Args) lastArg(name string) (string, origin, error) {
+	args.tokenize()
+
+	if result, ok := args.streamLast(); ok {
+		return result, origin{id: 0, argv: -1, env: false}, nil
+	}
+
+	idx := args.lastIndex()
+	if idx < 0 {
+		return "", origin{id: 0, argv: -1, env: false},
+			fmt.Errorf("%w: %s", ErrMissing, name)
+	}
+
+	result, from := args.args[idx], args.origins[idx]
+	args.dropArg(idx)
+
+	return result, from, nil
+}
+
+// lastIndex returns the index of the last positional argument or -1 if
+// there is none.
+func (args *Args) lastIndex() int {
+	idx := len(args.args) - 1
+	if idx >= 0 && idx == args.markerIndex() {
+		idx--
+	}
+
+	return idx
+}
+
+// restArgs removes all remaining positional arguments (including those read
+// from the argument stream) checking that there are at least minCount and
+// (unless negative) at most maxCount of them.
+func (args *Args) restArgs(
+	name string, minCount, maxCount int,
+) ([]string, []origin, error) {
+	var (
+		rest    []string
+		origins []origin
+	)
+
+	for args.HasNext() {
+		arg, from, _ := args.nextArg(name)
+		rest = append(rest, arg)
+		origins = append(origins, from)
+	}
+
+	args.setArgs(nil, nil)
+	args.terminated = true
+
+	switch {
+	case len(rest) < minCount:
+		return nil, nil, fmt.Errorf(
+			"%w: '%s' expects at least %d values but found %d",
+			ErrMissing,
+			name,
+			minCount,
+			len(rest),
+		)
+	case maxCount >= 0 && len(rest) > maxCount:
+		return nil, nil, fmt.Errorf(
+			"%w: '%s' expects at most %d values but found %d",
+			ErrUnexpected,
+			name,
+			maxCount,
+			len(rest),
+		)
+	}
+
+	return rest, origins, nil
+}
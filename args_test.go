@@ -166,6 +166,414 @@ func TestSzargs_Group(t *testing.T) {
 	chk.Stdout()
 }
 
+func TestSzargs_EndOfOptions(t *testing.T) {
+	chk := sztestlog.CaptureLogAndStderrAndStdout(t)
+	defer chk.Release()
+
+	args := szargs.New("description", []string{
+		"noProgName",
+		"-v",
+		"-n", "5",
+		"file1",
+		"--",
+		"-v",
+		"-n", "6",
+		"--",
+	})
+
+	chk.Int(args.Count("[-v | --verbose ...]", "verbose level"), 1)
+
+	num, found := args.ValueInt("[-n num]", "a number")
+	chk.True(found)
+	chk.Int(num, 5)
+
+	chk.StrSlice(
+		args.Args(),
+		[]string{"file1", "--", "-v", "-n", "6", "--"},
+	)
+
+	chk.Str(args.NextString("file", "a file"), "file1")
+	chk.Str(args.NextString("file", "a file"), "-v")
+	chk.Str(args.NextString("file", "a file"), "-n")
+
+	// No further flags are found once the marker has been consumed.
+	chk.False(args.Is("[--]", "a literal double dash"))
+
+	chk.Str(args.NextString("file", "a file"), "6")
+	chk.True(args.HasNext())
+	chk.Str(args.NextString("file", "a file"), "--")
+	chk.False(args.HasNext())
+
+	args.Done()
+
+	chk.NoErr(args.Err())
+
+	chk.Log()
+	chk.Stderr()
+	chk.Stdout()
+}
+
+func TestSzargs_EndOfOptions_Unused(t *testing.T) {
+	chk := sztestlog.CaptureLogAndStderrAndStdout(t)
+	defer chk.Release()
+
+	args := szargs.New("description", []string{
+		"noProgName",
+		"-v",
+		"--",
+	})
+
+	chk.True(args.Is("-v", "verbose"))
+	chk.False(args.HasNext())
+
+	args.Done()
+
+	chk.NoErr(args.Err())
+
+	args = szargs.New("description", []string{
+		"noProgName",
+		"--",
+		"-v",
+	})
+
+	chk.False(args.Is("-v", "verbose"))
+	chk.True(args.HasNext())
+
+	args.Done()
+
+	chk.Err(
+		args.Err(),
+		chk.ErrChain(
+			szargs.ErrUnexpected,
+			"[-v]",
+		),
+	)
+
+	chk.Log()
+	chk.Stderr()
+	chk.Stdout()
+}
+
+func TestSzargs_Group_TrailingValue(t *testing.T) {
+	chk := sztestlog.CaptureLogAndStderrAndStdout(t)
+	defer chk.Release()
+
+	args := szargs.New("description", []string{
+		"noProgName",
+		"-vn", "5",
+		"-qm7",
+	})
+
+	chk.True(args.Is("-v", "test v"))
+	chk.True(args.Is("-q", "test q"))
+
+	num, found := args.ValueInt("[-n num]", "a number")
+	chk.True(found)
+	chk.Int(num, 5)
+
+	num, found = args.ValueInt("[-m num]", "another number")
+	chk.True(found)
+	chk.Int(num, 7)
+
+	args.Done()
+
+	chk.NoErr(args.Err())
+
+	chk.Log()
+	chk.Stderr()
+	chk.Stdout()
+}
+
+func TestSzargs_Group_ValueLooksLikeGroup(t *testing.T) {
+	chk := sztestlog.CaptureLogAndStderrAndStdout(t)
+	defer chk.Release()
+
+	args := szargs.New("description", []string{
+		"noProgName",
+		"--pattern", "-abc",
+		"-name", "bob",
+	})
+
+	pattern, found := args.ValueString("[--pattern pat]", "the pattern")
+	chk.True(found)
+	chk.Str(pattern, "-abc")
+
+	chk.False(args.Is("-a", "test a"))
+
+	name, found := args.ValueString("[-name name]", "single dash long name")
+	chk.True(found)
+	chk.Str(name, "bob")
+
+	args.Done()
+
+	chk.NoErr(args.Err())
+
+	chk.Log()
+	chk.Stderr()
+	chk.Stdout()
+}
+
+func TestSzargs_Group_Unregistered(t *testing.T) {
+	chk := sztestlog.CaptureLogAndStderrAndStdout(t)
+	defer chk.Release()
+
+	args := szargs.New("description", []string{
+		"noProgName",
+		"-axy",
+		"-xyz",
+	})
+
+	chk.True(args.Is("-a", "test a"))
+
+	args.Done()
+
+	chk.Err(
+		args.Err(),
+		chk.ErrChain(
+			szargs.ErrUnexpected,
+			"[-xy -xyz]",
+		),
+	)
+
+	chk.Log()
+	chk.Stderr()
+	chk.Stdout()
+}
+
+func TestSzargs_OrderIndependent(t *testing.T) {
+	chk := sztestlog.CaptureLogAndStderrAndStdout(t)
+	defer chk.Release()
+
+	args := szargs.New("description", []string{
+		"noProgName",
+		"-n", "-v",
+		"-v",
+		"--name", "--",
+		"file",
+	})
+
+	args.RegisterUsage("[-n num]", "a value")
+	args.RegisterUsage("[--name name]", "a name")
+
+	chk.Int(args.Count("[-v ...]", "verbose level"), 1)
+
+	value, found := args.ValueString("[-n num]", "a value")
+	chk.True(found)
+	chk.Str(value, "-v")
+
+	value, found = args.ValueString("[--name name]", "a name")
+	chk.True(found)
+	chk.Str(value, "--")
+
+	chk.Str(args.NextString("file", "a file"), "file")
+
+	args.Done()
+
+	chk.NoErr(args.Err())
+
+	chk.Log()
+	chk.Stderr()
+	chk.Stdout()
+}
+
+func TestSzargs_OrderIndependent_Registered(t *testing.T) {
+	chk := sztestlog.CaptureNothing(t)
+	defer chk.Release()
+
+	for _, countFirst := range []bool{true, false} {
+		args := szargs.New("description", []string{
+			"noProgName",
+			"-n", "-v",
+		})
+
+		args.RegisterUsage("[-v ...]", "verbose level")
+		args.RegisterUsage("[-n num]", "a value")
+
+		var (
+			count int
+			value string
+			found bool
+		)
+
+		if countFirst {
+			count = args.Count("[-v ...]", "verbose level")
+			value, found = args.ValueString("[-n num]", "a value")
+		} else {
+			value, found = args.ValueString("[-n num]", "a value")
+			count = args.Count("[-v ...]", "verbose level")
+		}
+
+		chk.Int(count, 0)
+		chk.True(found)
+		chk.Str(value, "-v")
+
+		args.Done()
+
+		chk.NoErr(args.Err())
+	}
+}
+
+func TestSzargs_LateFlag(t *testing.T) {
+	chk := sztestlog.CaptureLogAndStderrAndStdout(t)
+	defer chk.Release()
+
+	args := szargs.New("description", []string{
+		"noProgName",
+		"-n", "-v",
+	})
+
+	chk.Int(args.Count("[-v ...]", "verbose level"), 1)
+
+	value, found := args.ValueString("[-n num]", "a value")
+	chk.False(found)
+	chk.Str(value, "")
+
+	chk.Err(
+		args.Err(),
+		chk.ErrChain(
+			szargs.ErrLateFlag,
+			"'[-n num]' changes the meaning of '-v' after extraction began",
+			szargs.ErrMissing,
+			"'[-n num]'",
+		),
+	)
+
+	chk.Log()
+	chk.Stderr()
+	chk.Stdout()
+}
+
+func TestSzargs_LateFlag_Examined(t *testing.T) {
+	chk := sztestlog.CaptureLogAndStderrAndStdout(t)
+	defer chk.Release()
+
+	args := szargs.New("description", []string{
+		"noProgName",
+		"-vx",
+	})
+
+	chk.Int(args.Count("[-x ...]", "extra level"), 0)
+	chk.True(args.Is("[-v]", "verbose"))
+
+	chk.Err(
+		args.Err(),
+		chk.ErrChain(
+			szargs.ErrLateFlag,
+			"'[-v]' changes the meaning of '-vx' after extraction began",
+		),
+	)
+
+	chk.Log()
+	chk.Stderr()
+	chk.Stdout()
+}
+
+func TestSzargs_LateFlag_Unaffected(t *testing.T) {
+	chk := sztestlog.CaptureLogAndStderrAndStdout(t)
+	defer chk.Release()
+
+	args := szargs.New("description", []string{
+		"noProgName",
+		"-v", "-n", "-5", "-ab",
+		"file",
+	})
+
+	chk.Int(args.Count("[-v ...]", "verbose level"), 1)
+	chk.True(args.Is("[-a]", "all"))
+
+	num, found := args.ValueInt("[-n num]", "a number")
+	chk.True(found)
+	chk.Int(num, -5)
+
+	chk.True(args.Is("[-b]", "brief"))
+	chk.Str(args.NextString("file", "a file"), "file")
+
+	args.Done()
+
+	chk.NoErr(args.Err())
+
+	chk.Log()
+	chk.Stderr()
+	chk.Stdout()
+}
+
+func TestSzargs_Negatable(t *testing.T) {
+	chk := sztestlog.CaptureLogAndStderrAndStdout(t)
+	defer chk.Release()
+
+	args := szargs.New("description", []string{
+		"noProgName",
+		"--color",
+		"--no-color",
+		"-vv",
+		"--no-verbose",
+		"-v",
+	})
+
+	chk.False(args.Is("[--[no-]color]", "colorize output"))
+	chk.Int(args.Count("[-v | --[no-]verbose ...]", "verbose level"), 1)
+	chk.False(args.Is("[--[no-]bold]", "bold output"))
+
+	args.Done()
+
+	chk.NoErr(args.Err())
+
+	args = szargs.New("description", []string{
+		"noProgName",
+		"--no-color",
+		"--color",
+	})
+
+	chk.True(args.Is("[--[no-]color]", "colorize output"))
+
+	args.Done()
+
+	chk.NoErr(args.Err())
+
+	chk.Log()
+	chk.Stderr()
+	chk.Stdout()
+}
+
+func TestSzargs_Polarity(t *testing.T) {
+	chk := sztestlog.CaptureNothing(t)
+	defer chk.Release()
+
+	args := szargs.New("description", []string{
+		"noProgName",
+		"--no-color",
+		"--bold",
+		"-v",
+		"-v",
+	})
+
+	result, found := args.Polarity("[--[no-]color]", "colorize output")
+	chk.False(result)
+	chk.True(found)
+
+	result, found = args.Polarity("[--[no-]bold]", "bold output")
+	chk.True(result)
+	chk.True(found)
+
+	result, found = args.Polarity("[--[no-]italic]", "italic output")
+	chk.False(result)
+	chk.False(found)
+
+	result, found = args.Polarity("[-v]", "verbose output")
+	chk.False(result)
+	chk.False(found)
+
+	args.Done()
+
+	chk.Err(
+		args.Err(),
+		chk.ErrChain(
+			szargs.ErrAmbiguous,
+			"'[-v]' found 2 times",
+		),
+	)
+}
+
 func TestSzargs_ProgramName(t *testing.T) {
 	chk := sztestlog.CaptureLogAndStderrAndStdout(t)
 	defer chk.Release()
@@ -0,0 +1,114 @@
+/*
+   Szerszam argument library: szargs.
+   Copyright (C) 2024-2025  Leslie Dancsecs
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU General Public License for more details.
+
+   You should have received a copy of the GNU General Public License
+   along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package szargs
+
+import (
+	"strings"
+	"unicode"
+)
+
+// Dialect selects the syntax used to recognize flags on the command line.
+// Flag specifications are always declared in the POSIX/GNU syntax (IE:
+// "[-v | --verbose]") and work unchanged under every dialect.
+type Dialect int
+
+// Syntax dialects.
+const (
+	// DialectGNU recognizes "-v", "--verbose", "--num=5", "-n5" and short
+	// option groups such as "-vx" (the default).
+	DialectGNU Dialect = iota
+	// DialectGo additionally recognizes long names with a single dash as in
+	// the standard flag package (IE: "-verbose" and "-count=3").  Short
+	// option groups are not expanded.
+	DialectGo
+	// DialectDOS additionally recognizes slash prefixed flags (IE: "/v",
+	// "/verbose", "/n:5" and "/count:3").  Short option groups are not
+	// expanded.
+	DialectDOS
+)
+
+// dosPrefix marks a flag in the DOS dialect.
+const dosPrefix = "/"
+
+// dosValueSep separates a flag from its value in the DOS dialect.
+const dosValueSep = ":"
+
+// SetDialect selects the syntax dialect used to recognize flags.  A single
+// dash long name in the Go dialect or a slash prefixed argument in the DOS
+// dialect is only recognized as a flag if it names a registered flag (see
+// RegisterUsage).
+func (args *Args) SetDialect(dialect Dialect) {
+	args.dialect = dialect
+}
+
+// dialectArg rewrites an argument given in the configured dialect into its
+// POSIX/GNU form.  Arguments not recognized as flags in the registry are
+// returned unchanged.
+func (args *Args) dialectArg(arg string, reg registry) string {
+	switch args.dialect {
+	case DialectGo:
+		if len(arg) > 2 && arg[0] == '-' && unicode.IsLetter(rune(arg[1])) {
+			name, value, hasValue := strings.Cut(arg, "=")
+
+			switch {
+			case len(name) > 2 && args.isRegistered("-"+name, reg):
+				return "-" + arg
+			case len(name) == 2 && hasValue:
+				return name + value
+			}
+		}
+	case DialectDOS:
+		if len(arg) > 1 && strings.HasPrefix(arg, dosPrefix) {
+			name, value, hasValue := strings.Cut(arg[1:], dosValueSep)
+
+			if len(name) == 1 && args.isRegistered("-"+name, reg) {
+				return "-" + name + value
+			}
+
+			if len(name) > 1 && args.isRegistered("--"+name, reg) {
+				if hasValue {
+					return "--" + name + "=" + value
+				}
+
+				return "--" + name
+			}
+		}
+	case DialectGNU:
+	}
+
+	return arg
+}
+
+// isRegistered returns true if the flag name is in the registry or, if
+// abbreviations are enabled, a long flag name is a prefix of one.
+func (args *Args) isRegistered(name string, reg registry) bool {
+	if _, known := reg[name]; known {
+		return true
+	}
+
+	if args.abbreviate && strings.HasPrefix(name, "--") {
+		for registered := range reg {
+			if strings.HasPrefix(registered, name) {
+				return true
+			}
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,133 @@
+/*
+   Szerszam argument library: szargs.
+   Copyright (C) 2024-2025  Leslie Dancsecs
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU General Public License for more details.
+
+   You should have received a copy of the GNU General Public License
+   along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package szargs_test
+
+import (
+	"testing"
+
+	"github.com/dancsecs/szargs"
+	"github.com/dancsecs/sztestlog"
+)
+
+func TestSzargs_Dialect_GNU(t *testing.T) {
+	chk := sztestlog.CaptureNothing(t)
+	defer chk.Release()
+
+	args := szargs.New("program description", []string{
+		"programName",
+		"-vn5",
+		"/verbose",
+	})
+
+	args.RegisterUsage("[-v | --verbose]", "verbose")
+
+	chk.True(args.Is("[-v | --verbose]", "verbose"))
+
+	num, found := args.ValueInt("[-n | --count num]", "the count")
+
+	chk.True(found)
+	chk.Int(num, 5)
+
+	chk.NoErr(args.Err())
+	chk.StrSlice(args.Args(), []string{"/verbose"})
+}
+
+func TestSzargs_Dialect_Go(t *testing.T) {
+	chk := sztestlog.CaptureNothing(t)
+	defer chk.Release()
+
+	args := szargs.New("program description", []string{
+		"programName",
+		"-verbose",
+		"-count=3",
+		"-n=4",
+		"-name", "theName",
+		"-3.5",
+		"-vx",
+	})
+
+	args.SetDialect(szargs.DialectGo)
+	args.RegisterUsage("[-c | --count num]", "the count")
+	args.RegisterUsage("[--name name]", "the name")
+
+	chk.True(args.Is("[-v | --verbose]", "verbose"))
+
+	count, found := args.ValueInt("[-c | --count num]", "the count")
+
+	chk.True(found)
+	chk.Int(count, 3)
+
+	num, found := args.ValueInt("[-n num]", "the number")
+
+	chk.True(found)
+	chk.Int(num, 4)
+
+	name, found := args.ValueString("[--name name]", "the name")
+
+	chk.True(found)
+	chk.Str(name, "theName")
+
+	chk.False(args.Is("[-x]", "never grouped"))
+
+	chk.NoErr(args.Err())
+	chk.StrSlice(args.Args(), []string{"-3.5", "-vx"})
+}
+
+func TestSzargs_Dialect_DOS(t *testing.T) {
+	chk := sztestlog.CaptureNothing(t)
+	defer chk.Release()
+
+	args := szargs.New("program description", []string{
+		"programName",
+		"/v",
+		"/count:3",
+		"/n:4",
+		"/name", "theName",
+		"/tmp/file",
+		"-x",
+	})
+
+	args.SetDialect(szargs.DialectDOS)
+	args.RegisterUsage("[-v | --verbose]", "verbose")
+	args.RegisterUsage("[-c | --count num]", "the count")
+	args.RegisterUsage("[-n num]", "the number")
+	args.RegisterUsage("[--name name]", "the name")
+
+	chk.True(args.Is("[-v | --verbose]", "verbose"))
+
+	count, found := args.ValueInt("[-c | --count num]", "the count")
+
+	chk.True(found)
+	chk.Int(count, 3)
+
+	num, found := args.ValueInt("[-n num]", "the number")
+
+	chk.True(found)
+	chk.Int(num, 4)
+
+	name, found := args.ValueString("[--name name]", "the name")
+
+	chk.True(found)
+	chk.Str(name, "theName")
+
+	chk.True(args.Is("[-x]", "posix form"))
+
+	chk.NoErr(args.Err())
+	chk.StrSlice(args.Args(), []string{"/tmp/file"})
+}
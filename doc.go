@@ -23,13 +23,35 @@ settings from command-line arguments ([]string) and environment variables.
 It supports three types of arguments:
   - Flagged: Identified by a single dash (e.g., "-v") for short flags, or a
     double dash (e.g., "--dir") for long-form flags. Flags may be standalone
-    booleans or followed by a value.
+    booleans or followed by a value. A value may also be attached to the
+    flag itself as in "--dir=/tmp" for long-form flags or "-n5" for short
+    flags. Registered short flags may be grouped (e.g., "-vx" for "-v -x")
+    with a trailing flag in the group taking the rest of the group or the
+    following argument as its value (e.g., "-vn5" or "-vn 5"). Go style
+    ("-verbose") and DOS style ("/verbose:value") flags may be accepted by
+    selecting a dialect with `Args.SetDialect`.
   - Positional: Identified by their order in the argument list after all
     flagged arguments have been processed.
   - Settings: A composite configuration mechanism that combines a default
     value, an environment variable, and a flagged argument—allowing each to
     override the previous in precedence: default < env < flag.
 
+An argument consisting of a double dash ("--") marks the end of the flagged
+arguments. Flags are never extracted from the arguments following it and
+they are returned verbatim as positional arguments. In POSIXLY_CORRECT mode
+(see `Args.SetPosixlyCorrect`) the first positional argument ends the flagged
+arguments in the same way.
+
+Flags are registered as they are extracted. The arguments are tokenized once,
+when the first one is extracted, and a value following a registered flag is
+never mistaken for another flag. A flag registered later is reconciled with
+the remaining arguments. If it changes the meaning of an argument already
+extracted (IE: "-v" was counted before "-n" was found to take it as a value)
+an ErrLateFlag error is registered rather than silently returning results
+that depend on the order of extraction. Results are only independent of the
+order of extraction once every flag is registered up front with
+`Args.RegisterUsage`.
+
 The package includes built-in parsers for standard Go data types.
 
 Usage centers around the Args type, created using:
@@ -0,0 +1,155 @@
+/*
+   Szerszam argument library: szargs.
+   Copyright (C) 2024-2025  Leslie Dancsecs
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU General Public License for more details.
+
+   You should have received a copy of the GNU General Public License
+   along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package szargs
+
+import (
+	"errors"
+	"math"
+	"regexp"
+	"strconv"
+	"time"
+)
+
+// durationSyntax matches the syntax accepted by time.ParseDuration allowing
+// an overflow to be reported as a range error rather than a syntax error.
+var durationSyntax = regexp.MustCompile(
+	`^[-+]?(0|((\d+\.?\d*|\.\d+)(ns|us|µs|μs|ms|s|m|h))+)$`,
+)
+
+// ParseDuration parses a duration in Go syntax (IE: "1h30m").
+var ParseDuration = DurationParser(0)
+
+// DurationParser returns a parser accepting a duration in Go syntax (IE:
+// "1h30m") or, if the unit is not zero, a bare number of units (IE: "1.5" is
+// 1500ms when the unit is time.Second).
+func DurationParser(unit time.Duration) Parser[time.Duration] {
+	return ParserFunc[time.Duration](
+		func(name, str string) (time.Duration, error) {
+			return parseDuration(name, str, unit)
+		},
+	)
+}
+
+func parseDuration(
+	name, str string, unit time.Duration,
+) (time.Duration, error) {
+	if unit != 0 {
+		number, err := strconv.ParseFloat(str, bits64)
+		if err == nil || errors.Is(err, strconv.ErrRange) {
+			return scaleDuration(name, str, number, unit, err)
+		}
+	}
+
+	result, err := time.ParseDuration(str)
+	if err != nil {
+		if durationSyntax.MatchString(str) {
+			err = strconv.ErrRange
+		}
+
+		err = makeParseErr(ErrInvalidDuration, err, name, str)
+	}
+
+	return result, err
+}
+
+// scaleDuration multiplies the number by the unit.  Numbers that are not a
+// number (NaN) are rejected as invalid syntax while infinite numbers and
+// those scaling beyond the range of a time.Duration are out of range.
+func scaleDuration(
+	name, str string, number float64, unit time.Duration, err error,
+) (time.Duration, error) {
+	scaled := number * float64(unit)
+
+	switch {
+	case err != nil:
+	case math.IsNaN(scaled):
+		err = strconv.ErrSyntax
+	case math.IsInf(scaled, 0) ||
+		scaled >= math.MaxInt64 || scaled < math.MinInt64:
+		err = strconv.ErrRange
+	}
+
+	if err != nil {
+		return 0, makeParseErr(ErrInvalidDuration, err, name, str)
+	}
+
+	return time.Duration(math.Round(scaled)), nil
+}
+
+// SetDurationUnit sets the unit applied to bare numbers (IE: "30") given to
+// the Duration family of methods.  A zero unit (the default) requires every
+// duration to be provided in Go syntax (IE: "30s").
+func (args *Args) SetDurationUnit(unit time.Duration) {
+	args.durationUnit = unit
+}
+
+// durationParser returns the parser used by the Duration family of methods.
+func (args *Args) durationParser() Parser[time.Duration] {
+	return DurationParser(args.durationUnit)
+}
+
+// ValueDuration scans for a specific flagged argument and parses its value as
+// a time.Duration. The flag and its value are removed from the argument list.
+//
+// If the flag appears more than once, lacks a following value, or if the
+// value has invalid syntax or is out of range for a time.Duration, an error
+// is registered.
+//
+// Returns the parsed value and a boolean indicating whether the flag was
+// found.
+func (args *Args) ValueDuration(flag, desc string) (time.Duration, bool) {
+	return Value(args, args.durationParser(), flag, desc)
+}
+
+// ValuesDuration scans for repeated instances of the specified flag and
+// parses the following values as time.Durations. The flags and values are
+// removed from the argument list.
+//
+// If any flag lacks a following value, or if a value has invalid syntax or is
+// out of range for a time.Duration, an error is registered.
+//
+// Returns a slice of the parsed time.Duration values.
+func (args *Args) ValuesDuration(flag, desc string) []time.Duration {
+	return Values(args, args.durationParser(), flag, desc)
+}
+
+// SettingDuration returns a configuration value based on a default,
+// optionally overridden by an environment variable, and further overridden by
+// a flagged command-line argument. The value is parsed as a time.Duration.
+//
+// If the final value has invalid syntax or is out of range for a
+// time.Duration, an error is registered.
+//
+// Returns the final parsed time.Duration value.
+func (args *Args) SettingDuration(
+	flag, env string, def time.Duration, desc string,
+) time.Duration {
+	return Setting(args, args.durationParser(), flag, env, def, desc)
+}
+
+// NextDuration removes and returns the next argument from the argument list,
+// parsing it as a time.Duration.
+//
+// If no arguments remain, or if the value has invalid syntax or is out of
+// range for a time.Duration, an error is registered.
+//
+// Returns the next argument value parsed as a time.Duration.
+func (args *Args) NextDuration(name, desc string) time.Duration {
+	return Next(args, args.durationParser(), name, desc)
+}
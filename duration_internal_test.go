@@ -0,0 +1,120 @@
+/*
+   Szerszam argument library: szargs.
+   Copyright (C) 2024-2025  Leslie Dancsecs
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU General Public License for more details.
+
+   You should have received a copy of the GNU General Public License
+   along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package szargs
+
+import (
+	"testing"
+	"time"
+
+	"github.com/dancsecs/sztestlog"
+)
+
+func TestSzargs_ParseDuration(t *testing.T) {
+	chk := sztestlog.CaptureNothing(t)
+	defer chk.Release()
+
+	result, err := parseDuration("d", "1h30m", 0)
+	chk.NoErr(err)
+	chk.Int64(int64(result), int64(90*time.Minute))
+
+	result, err = parseDuration("d", "-1.5s", time.Minute)
+	chk.NoErr(err)
+	chk.Int64(int64(result), int64(-1500*time.Millisecond))
+
+	result, err = parseDuration("d", "0", 0)
+	chk.NoErr(err)
+	chk.Int64(int64(result), 0)
+
+	result, err = parseDuration("d", "30", 0)
+	chk.Err(
+		err,
+		chk.ErrChain(ErrInvalidDuration, ErrSyntax, "d", "'30'"),
+	)
+	chk.Int64(int64(result), 0)
+
+	result, err = parseDuration("d", "3x", 0)
+	chk.Err(
+		err,
+		chk.ErrChain(ErrInvalidDuration, ErrSyntax, "d", "'3x'"),
+	)
+	chk.Int64(int64(result), 0)
+
+	result, err = parseDuration("d", "3000000h", 0)
+	chk.Err(
+		err,
+		chk.ErrChain(ErrInvalidDuration, ErrRange, "d", "'3000000h'"),
+	)
+	chk.Int64(int64(result), 0)
+}
+
+func TestSzargs_ParseDuration_Unit(t *testing.T) {
+	chk := sztestlog.CaptureNothing(t)
+	defer chk.Release()
+
+	result, err := parseDuration("d", "30", time.Second)
+	chk.NoErr(err)
+	chk.Int64(int64(result), int64(30*time.Second))
+
+	result, err = parseDuration("d", "1.5", time.Second)
+	chk.NoErr(err)
+	chk.Int64(int64(result), int64(1500*time.Millisecond))
+
+	result, err = parseDuration("d", "-2", time.Millisecond)
+	chk.NoErr(err)
+	chk.Int64(int64(result), int64(-2*time.Millisecond))
+
+	result, err = parseDuration("d", "3000000", time.Hour)
+	chk.Err(
+		err,
+		chk.ErrChain(ErrInvalidDuration, ErrRange, "d", "'3000000'"),
+	)
+	chk.Int64(int64(result), 0)
+
+	result, err = parseDuration("d", "1e400", time.Second)
+	chk.Err(
+		err,
+		chk.ErrChain(ErrInvalidDuration, ErrRange, "d", "'1e400'"),
+	)
+	chk.Int64(int64(result), 0)
+
+	for _, str := range []string{"NaN", "-nan"} {
+		result, err = parseDuration("d", str, time.Second)
+		chk.Err(
+			err,
+			chk.ErrChain(ErrInvalidDuration, ErrSyntax, "d", "'"+str+"'"),
+		)
+		chk.Int64(int64(result), 0)
+	}
+
+	for _, str := range []string{"Inf", "+inf", "-Infinity"} {
+		result, err = parseDuration("d", str, time.Second)
+		chk.Err(
+			err,
+			chk.ErrChain(ErrInvalidDuration, ErrRange, "d", "'"+str+"'"),
+		)
+		chk.Int64(int64(result), 0)
+	}
+
+	result, err = parseDuration("d", "ten", time.Second)
+	chk.Err(
+		err,
+		chk.ErrChain(ErrInvalidDuration, ErrSyntax, "d", "'ten'"),
+	)
+	chk.Int64(int64(result), 0)
+}
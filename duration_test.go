@@ -0,0 +1,139 @@
+/*
+   Szerszam argument library: szargs.
+   Copyright (C) 2024-2025  Leslie Dancsecs
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU General Public License for more details.
+
+   You should have received a copy of the GNU General Public License
+   along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package szargs_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/dancsecs/szargs"
+	"github.com/dancsecs/sztestlog"
+)
+
+func TestSzargs_Duration(t *testing.T) {
+	chk := sztestlog.CaptureNothing(t)
+	defer chk.Release()
+
+	args := szargs.New("program description", []string{
+		"programName",
+		"--timeout", "1m30s",
+		"-i", "1s",
+		"-i", "250ms",
+		"2h",
+	})
+
+	timeout, found := args.ValueDuration("[--timeout dur]", "the timeout")
+
+	chk.True(found)
+	chk.Int64(int64(timeout), int64(90*time.Second))
+
+	chk.Int64Slice(
+		durationsToInt64(args.ValuesDuration("[-i dur ...]", "intervals")),
+		[]int64{int64(time.Second), int64(250 * time.Millisecond)},
+	)
+
+	chk.Int64(
+		int64(args.SettingDuration(tstArgFlag, tstEnv, time.Minute, "d")),
+		int64(time.Minute),
+	)
+
+	chk.Int64(int64(args.NextDuration("span", "the span")), int64(2*time.Hour))
+
+	args.Done()
+
+	chk.NoErr(args.Err())
+}
+
+func TestSzargs_Duration_Unit(t *testing.T) {
+	chk := sztestlog.CaptureNothing(t)
+	defer chk.Release()
+
+	chk.SetEnv(tstEnv, "45")
+
+	args := szargs.New("program description", []string{
+		"programName",
+		"--timeout", "1.5",
+		"2h",
+	})
+
+	args.SetDurationUnit(time.Second)
+
+	timeout, found := args.ValueDuration("[--timeout dur]", "the timeout")
+
+	chk.True(found)
+	chk.Int64(int64(timeout), int64(1500*time.Millisecond))
+
+	chk.Int64(
+		int64(args.SettingDuration(tstArgFlag, tstEnv, time.Minute, "d")),
+		int64(45*time.Second),
+	)
+
+	chk.Int64(int64(args.NextDuration("span", "the span")), int64(2*time.Hour))
+
+	args.Done()
+
+	chk.NoErr(args.Err())
+}
+
+func TestSzargs_Duration_Invalid(t *testing.T) {
+	chk := sztestlog.CaptureNothing(t)
+	defer chk.Release()
+
+	chk.SetEnv(tstEnv, "45")
+
+	args := szargs.New("program description", []string{
+		"programName",
+		"--timeout", "3000000h",
+	})
+
+	timeout, found := args.ValueDuration("[--timeout dur]", "the timeout")
+
+	chk.False(found)
+	chk.Int64(int64(timeout), 0)
+
+	chk.Int64(
+		int64(args.SettingDuration(tstArgFlag, tstEnv, time.Minute, "d")),
+		0,
+	)
+
+	chk.Err(
+		args.Err(),
+		chk.ErrChain(
+			szargs.ErrInvalidDuration,
+			szargs.ErrRange,
+			"[--timeout dur]",
+			"'3000000h'",
+			szargs.ErrInvalidEnv.Error()+": "+
+				szargs.ErrInvalidDuration.Error(),
+			szargs.ErrSyntax,
+			tstEnv,
+			"'45'",
+		),
+	)
+}
+
+func durationsToInt64(durations []time.Duration) []int64 {
+	result := make([]int64, len(durations))
+
+	for i, d := range durations {
+		result[i] = int64(d)
+	}
+
+	return result
+}
@@ -0,0 +1,85 @@
+/*
+   Szerszam argument library: szargs.
+   Copyright (C) 2024-2025  Leslie Dancsecs
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU General Public License for more details.
+
+   You should have received a copy of the GNU General Public License
+   along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package szargs
+
+import (
+	"fmt"
+	"os"
+)
+
+// PrependEnvArgs inserts the arguments held in the named environment variable
+// (IE: "APP_ARGS") ahead of the program arguments.  The variable is split into
+// arguments using the same shell-like quoting rules as response files.  This
+// lets users set persistent defaults for any flag.  As the prepended
+// arguments precede the command line a flag repeated on the command line only
+// overrides one from the environment variable if repeats are allowed.
+//
+// It should be called immediately after New before any arguments are
+// extracted.  An unset or empty variable is ignored.  A flag in the variable
+// never takes its values from the command line.  Syntax errors in the
+// variable, flags missing values in it, values taken from it that cannot be
+// parsed and any of its arguments left unexpected by Done register an error
+// naming the environment variable.
+func (args *Args) PrependEnvArgs(env string) {
+	words, _, err := splitShell(os.Getenv(env))
+	if err != nil {
+		args.PushErr(fmt.Errorf("%w: %s: %w", ErrInvalidEnv, env, err))
+
+		return
+	}
+
+	envArgs := make([]string, len(words))
+	for i, word := range words {
+		envArgs[i] = word.text
+	}
+
+	args.setArgs(
+		append(envArgs, args.args...),
+		append(
+			args.newOrigins(
+				len(envArgs), origin{id: 0, argv: -1, env: true},
+			),
+			args.origins...,
+		),
+	)
+	args.envArgsName = env
+}
+
+// splitEnvArgs separates the remaining positional arguments into those that
+// originated from the environment and those that did not.
+func (args *Args) splitEnvArgs() ([]string, []string) {
+	var (
+		fromEnv  []string
+		fromArgs []string
+	)
+
+	marker := args.markerIndex()
+
+	for i, arg := range args.args {
+		switch {
+		case i == marker:
+		case args.origins[i].env:
+			fromEnv = append(fromEnv, arg)
+		default:
+			fromArgs = append(fromArgs, arg)
+		}
+	}
+
+	return fromEnv, fromArgs
+}
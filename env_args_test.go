@@ -0,0 +1,262 @@
+/*
+   Szerszam argument library: szargs.
+   Copyright (C) 2024-2025  Leslie Dancsecs
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU General Public License for more details.
+
+   You should have received a copy of the GNU General Public License
+   along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package szargs_test
+
+import (
+	"testing"
+
+	"github.com/dancsecs/szargs"
+	"github.com/dancsecs/sztestlog"
+)
+
+const tstEnvArgs = "SZARGS_TESTING_ENV_ARGS"
+
+func TestSzargs_PrependEnvArgs_Unset(t *testing.T) {
+	chk := sztestlog.CaptureNothing(t)
+	defer chk.Release()
+
+	chk.DelEnv(tstEnvArgs)
+
+	args := szargs.New("program description", []string{
+		"programName",
+		"file",
+	})
+
+	args.PrependEnvArgs(tstEnvArgs)
+
+	chk.NoErr(args.Err())
+	chk.StrSlice(args.Args(), []string{"file"})
+}
+
+func TestSzargs_PrependEnvArgs(t *testing.T) {
+	chk := sztestlog.CaptureNothing(t)
+	defer chk.Release()
+
+	chk.SetEnv(tstEnvArgs, "-v --name 'the name'")
+
+	args := szargs.New("program description", []string{
+		"programName",
+		"file",
+	})
+
+	args.PrependEnvArgs(tstEnvArgs)
+
+	chk.StrSlice(args.Args(), []string{"-v", "--name", "the name", "file"})
+
+	chk.True(args.Is("[-v | --verbose]", "verbose"))
+
+	name, found := args.ValueString("[-n | --name name]", "the name")
+
+	chk.True(found)
+	chk.Str(name, "the name")
+
+	chk.Str(args.NextString("filename", "the file"), "file")
+
+	args.Done()
+
+	chk.NoErr(args.Err())
+}
+
+func TestSzargs_PrependEnvArgs_Syntax(t *testing.T) {
+	chk := sztestlog.CaptureNothing(t)
+	defer chk.Release()
+
+	chk.SetEnv(tstEnvArgs, "-v \"open")
+
+	args := szargs.New("program description", []string{
+		"programName",
+		"file",
+	})
+
+	args.PrependEnvArgs(tstEnvArgs)
+
+	chk.Err(
+		args.Err(),
+		chk.ErrChain(
+			szargs.ErrInvalidEnv,
+			tstEnvArgs,
+			szargs.ErrSyntax,
+			"unterminated quote (\")",
+		),
+	)
+	chk.StrSlice(args.Args(), []string{"file"})
+}
+
+func TestSzargs_PrependEnvArgs_Unexpected(t *testing.T) {
+	chk := sztestlog.CaptureNothing(t)
+	defer chk.Release()
+
+	chk.SetEnv(tstEnvArgs, "-x extra")
+
+	args := szargs.New("program description", []string{
+		"programName",
+		"file",
+		"extra",
+	})
+
+	args.PrependEnvArgs(tstEnvArgs)
+
+	chk.Str(args.NextString("filename", "the file"), "-x")
+
+	args.Done()
+
+	chk.Err(
+		args.Err(),
+		chk.ErrChain(
+			szargs.ErrUnexpected,
+			"[file extra]",
+			szargs.ErrUnexpected,
+			"[extra] from environment variable "+tstEnvArgs,
+		),
+	)
+}
+
+func TestSzargs_PrependEnvArgs_UnexpectedByPosition(t *testing.T) {
+	chk := sztestlog.CaptureNothing(t)
+	defer chk.Release()
+
+	chk.SetEnv(tstEnvArgs, "file")
+
+	args := szargs.New("program description", []string{
+		"programName",
+		"file",
+		"extra",
+	})
+
+	args.PrependEnvArgs(tstEnvArgs)
+
+	chk.Str(args.NextString("filename", "the file"), "file")
+
+	args.Done()
+
+	chk.Err(
+		args.Err(),
+		chk.ErrChain(
+			szargs.ErrUnexpected,
+			"[file extra]",
+		),
+	)
+}
+
+func TestSzargs_PrependEnvArgs_Invalid(t *testing.T) {
+	chk := sztestlog.CaptureNothing(t)
+	defer chk.Release()
+
+	chk.SetEnv(tstEnvArgs, "-n abc -r 1,x two")
+
+	args := szargs.New("program description", []string{
+		"programName",
+		"-n", "5",
+		"3",
+	})
+
+	args.SetListSeparator(",")
+	args.SetRepeatPolicy(szargs.RepeatFirstWins)
+	args.PrependEnvArgs(tstEnvArgs)
+
+	num, found := args.ValueInt("[-n num]", "a number")
+	chk.False(found)
+	chk.Int(num, 0)
+
+	chk.IntSlice(args.ValuesInt("[-r num ...]", "the ranges"), nil)
+	chk.Int(args.NextInt("count", "the count"), 0)
+	chk.Int(args.NextInt("size", "the size"), 3)
+
+	args.Done()
+
+	chk.Err(
+		args.Err(),
+		chk.ErrChain(
+			szargs.ErrInvalidInt,
+			szargs.ErrSyntax,
+			"[-n num]",
+			"'abc' from environment variable "+tstEnvArgs,
+			szargs.ErrInvalidInt,
+			szargs.ErrSyntax,
+			"[-r num ...]",
+			"'x' from environment variable "+tstEnvArgs,
+			szargs.ErrInvalidInt,
+			szargs.ErrSyntax,
+			"count",
+			"'two' from environment variable "+tstEnvArgs,
+		),
+	)
+}
+
+func TestSzargs_PrependEnvArgs_MissingValue(t *testing.T) {
+	chk := sztestlog.CaptureNothing(t)
+	defer chk.Release()
+
+	chk.SetEnv(tstEnvArgs, "--out")
+
+	args := szargs.New("program description", []string{
+		"programName",
+		"input.txt",
+	})
+
+	args.PrependEnvArgs(tstEnvArgs)
+
+	out, found := args.ValueString("[--out file]", "the output")
+	chk.False(found)
+	chk.Str(out, "")
+
+	chk.Str(args.NextString("input", "the input"), "input.txt")
+
+	args.Done()
+
+	chk.Err(
+		args.Err(),
+		chk.ErrChain(
+			szargs.ErrMissing,
+			"'--out' from environment variable "+tstEnvArgs,
+		),
+	)
+}
+
+func TestSzargs_PrependEnvArgs_MissingValueLate(t *testing.T) {
+	chk := sztestlog.CaptureNothing(t)
+	defer chk.Release()
+
+	chk.SetEnv(tstEnvArgs, "-v --out")
+
+	args := szargs.New("program description", []string{
+		"programName",
+		"input.txt",
+	})
+
+	args.PrependEnvArgs(tstEnvArgs)
+
+	chk.True(args.Is("[-v]", "verbose"))
+
+	out, found := args.ValueString("[--out file]", "the output")
+	chk.False(found)
+	chk.Str(out, "")
+
+	chk.Str(args.NextString("input", "the input"), "input.txt")
+
+	args.Done()
+
+	chk.Err(
+		args.Err(),
+		chk.ErrChain(
+			szargs.ErrMissing,
+			"'--out' from environment variable "+tstEnvArgs,
+		),
+	)
+}
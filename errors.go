@@ -24,23 +24,33 @@ import (
 
 // Exported errors.
 var (
-	ErrNoArgs         = errors.New("no program arguments provided")
-	ErrSyntax         = errors.New("syntax")
-	ErrRange          = errors.New("range")
-	ErrInvalidFloat64 = errors.New("invalid float64")
-	ErrInvalidFloat32 = errors.New("invalid float32")
-	ErrInvalidInt64   = errors.New("invalid int64")
-	ErrInvalidInt16   = errors.New("invalid int16")
-	ErrInvalidInt32   = errors.New("invalid int32")
-	ErrInvalidInt8    = errors.New("invalid int8")
-	ErrInvalidInt     = errors.New("invalid int")
-	ErrInvalidUint64  = errors.New("invalid uint64")
-	ErrInvalidUint32  = errors.New("invalid uint32")
-	ErrInvalidUint16  = errors.New("invalid uint16")
-	ErrInvalidUint8   = errors.New("invalid uint8")
-	ErrInvalidUint    = errors.New("invalid uint")
-	ErrInvalidOption  = errors.New("invalid option")
-	ErrInvalidDefault = errors.New("invalid default")
-	ErrInvalidFlag    = errors.New("invalid flag")
-	ErrInvalidEnv     = errors.New("invalid environment variable")
+	ErrNoArgs          = errors.New("no program arguments provided")
+	ErrSyntax          = errors.New("syntax")
+	ErrRange           = errors.New("range")
+	ErrInvalidFloat64  = errors.New("invalid float64")
+	ErrInvalidFloat32  = errors.New("invalid float32")
+	ErrInvalidInt64    = errors.New("invalid int64")
+	ErrInvalidInt16    = errors.New("invalid int16")
+	ErrInvalidInt32    = errors.New("invalid int32")
+	ErrInvalidInt8     = errors.New("invalid int8")
+	ErrInvalidInt      = errors.New("invalid int")
+	ErrInvalidUint64   = errors.New("invalid uint64")
+	ErrInvalidUint32   = errors.New("invalid uint32")
+	ErrInvalidUint16   = errors.New("invalid uint16")
+	ErrInvalidUint8    = errors.New("invalid uint8")
+	ErrInvalidUint     = errors.New("invalid uint")
+	ErrInvalidOption   = errors.New("invalid option")
+	ErrInvalidDefault  = errors.New("invalid default")
+	ErrInvalidFlag     = errors.New("invalid flag")
+	ErrInvalidEnv      = errors.New("invalid environment variable")
+	ErrInvalidList     = errors.New("invalid list")
+	ErrResponseFile    = errors.New("invalid response file")
+	ErrSegment         = errors.New("argument segment")
+	ErrArgStream       = errors.New("argument stream")
+	ErrInvalidAlias    = errors.New("invalid alias")
+	ErrLateFlag        = errors.New("late flag registration")
+	ErrInvalidText     = errors.New("invalid text")
+	ErrInvalidDuration = errors.New("invalid duration")
+	ErrInvalidTime     = errors.New("invalid time")
+	ErrInvalidTimeZone = errors.New("invalid time zone")
 )
@@ -17,7 +17,8 @@ const (
 	numberDesc = "The numbers to act on."
 
 	operationName = "[operation]"
-	operationDesc = "The operation (add or average) defaulting to add."
+	operationDesc = "The operation (add or average)."
+	operationDef  = "add"
 )
 
 // Example function being tested.
@@ -33,17 +34,14 @@ func main() {
 	// Gather all of the number to operate on.
 	numbers := args.ValuesFloat64(numberFlag, numberDesc)
 
-	// Set to default if not present.
-	if !args.HasNext() {
-		args.PushArg("add")
-	}
-
-	operation := args.NextOption(
+	// Defaults to add if not present.
+	operation := args.NextOptionOr(
 		operationName,
 		[]string{
 			"add",
 			"average",
 		},
+		operationDef,
 		operationDesc,
 	)
 
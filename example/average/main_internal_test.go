@@ -40,7 +40,9 @@ const usageText = "" +
 	"        The numbers to act on.\n" +
 	"\n" +
 	"    [operation]\n" +
-	"        The operation (add or average) defaulting to add." +
+	"        The operation (add or average).\n" +
+	"\n" +
+	"        default: add" +
 	""
 
 // Passing test.
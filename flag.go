@@ -34,70 +34,198 @@ var (
 // argFlag represents a single argument.
 type argFlag string
 
-func (a argFlag) argIs(arg string) bool {
+// parse returns the flag names declared in the argument specification along
+// with the name of the value following the flag (if any).
+func (a argFlag) parse() ([]string, string) {
+	var names []string
+
 	// Remove optional [], mandatory {} and repeating ... frames.
 	cleanFlg := strings.Trim(string(a), "[]{}.")
 
 	for flgEntry := range strings.SplitSeq(cleanFlg, "|") {
 		flg := strings.Split(strings.TrimSpace(flgEntry), " ")
 
-		if flg[0] == arg {
-			return true
-		}
+		names = append(names, flg[0])
 
 		if len(flg) > 1 {
 			// Stop optional arg name.  IE: [-n | --name all|theName]
-			break
+			return names, strings.Join(flg[1:], " ")
+		}
+	}
+
+	return names, ""
+}
+
+// negatable marks a long flag name that may be negated.  IE: --[no-]color
+const negatable = "[no-]"
+
+// optionalMarker marks a flag whose value is optional and may only be
+// attached to the flag itself.  IE: --color[=when]
+const optionalMarker = "[="
+
+// names returns the flag names declared in the argument specification.
+func (a argFlag) names() []string {
+	names, _ := a.parse()
+
+	for i, name := range names {
+		name, _, _ = strings.Cut(name, optionalMarker)
+		names[i] = strings.Replace(name, negatable, "", 1)
+	}
+
+	return names
+}
+
+// isOptional returns true if the specification marks the flag's value as
+// optional.  IE: [--color[=when]]
+func (a argFlag) isOptional() bool {
+	names, _ := a.parse()
+
+	for _, name := range names {
+		if strings.Contains(name, optionalMarker) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// specValues returns the number of values declared in the argument
+// specification.  A flag takes a value for each value named (IE: [-n | --num
+// numOfLines] or [--rect x y w h]) or an optional value if marked as such
+// (IE: [--color[=when]]).
+func (a argFlag) specValues() int {
+	_, valueName := a.parse()
+
+	if a.isOptional() {
+		return optionalValue
+	}
+
+	return len(strings.Fields(valueName))
+}
+
+// negatedNames returns the negated form (IE: --no-color) of any negatable
+// flag names declared in the argument specification.
+func (a argFlag) negatedNames() []string {
+	var negated []string
+
+	names, _ := a.parse()
+
+	for _, name := range names {
+		if strings.Contains(name, negatable) {
+			negated = append(
+				negated,
+				strings.Replace(name, negatable, "no-", 1),
+			)
+		}
+	}
+
+	return negated
+}
+
+// allNames returns both the declared and negated flag names.
+func (a argFlag) allNames() []string {
+	return append(a.names(), a.negatedNames()...)
+}
+
+func (a argFlag) argIs(arg string) bool {
+	for _, name := range a.names() {
+		if name == arg {
+			return true
 		}
 	}
 
 	return false
 }
 
+func (a argFlag) argIsNegated(arg string) bool {
+	for _, name := range a.negatedNames() {
+		if name == arg {
+			return true
+		}
+	}
+
+	return false
+}
+
+// argValue returns the value attached directly to the flag in a single
+// argument.  Long names accept the "--name=value" form while single letter
+// names accept the "-nVALUE" form.
+func (a argFlag) argValue(arg string) (string, bool) {
+	for _, name := range a.names() {
+		switch {
+		case strings.HasPrefix(name, "--"):
+			value, ok := strings.CutPrefix(arg, name+"=")
+			if ok {
+				return value, true
+			}
+		case len(name) == 2 && name[0] == '-' && name[1] != '-':
+			if len(arg) > 2 && strings.HasPrefix(arg, name) {
+				return arg[2:], true
+			}
+		}
+	}
+
+	return "", false
+}
+
 // count scans argument array (args) removing and counting the number of
-// times the argument is encountered.
-func (a argFlag) count(args []string) (int, []string) {
+// times the argument is encountered.  A negated flag (IE: --no-verbose)
+// resets the count.
+func (a argFlag) count(args []string, reg registry) (int, []string) {
 	count := 0
-	cleanedArgs := make([]string, 0, len(args))
+	found := a.find(args, noValue, reg)
 
-	for _, arg := range args {
-		if a.argIs(arg) {
-			count++
+	for _, occ := range found {
+		if occ.negated {
+			count = 0
 		} else {
-			cleanedArgs = append(cleanedArgs, arg)
+			count++
 		}
 	}
 
-	return count, cleanedArgs
+	return count, removeOccurrences(args, found)
 }
 
-// is scans the args counting and removing the arg from the list.  If the
-// argument appears more than once an ErrAmbiguous is returned.
-func (a argFlag) is(args []string) (bool, []string, error) {
-	var count int
+// polarity scans the args removing the flag from the list.  It returns true
+// if the flag was present and not negated along with a second boolean
+// indicating if the flag (negated or not) was present at all.  A negatable
+// flag may appear multiple times with the last one seen winning unless the
+// policy selects the first.  Otherwise if the flag appears more than once an
+// ErrAmbiguous is returned unless permitted by the policy.
+func (a argFlag) polarity(
+	args []string, reg registry, policy RepeatPolicy,
+) (bool, bool, []string, error) {
+	found := a.find(args, noValue, reg)
+	cleanedArgs := removeOccurrences(args, found)
+	found = policy.apply(found)
 
-	count, args = a.count(args)
-	if count > 1 {
-		return false, args,
+	if len(found) > 1 && len(a.negatedNames()) == 0 {
+		return false, false, cleanedArgs,
 			fmt.Errorf(
 				"%w: '%s' found %d times",
 				ErrAmbiguous,
 				a,
-				count,
+				len(found),
 			)
 	}
 
-	return count == 1, args, nil
+	if len(found) == 0 {
+		return false, false, cleanedArgs, nil
+	}
+
+	return !found[len(found)-1].negated, true, cleanedArgs, nil
 }
 
 // Value scans the args looking for the specified flag.  If it finds
-// it then the next arg as the value absorbing both the flag the value
-// from the argument list.  If there is no next arg or the flag appears more
-// than once an error is returned.
-func (a argFlag) value(args []string) (string, bool, []string, error) {
+// it then the next arg (or the value attached to the flag itself) is taken as
+// the value absorbing both the flag the value from the argument list.  If
+// there is no value or the flag appears more than once (unless permitted by
+// the policy) an error is returned.
+func (a argFlag) value(
+	args []string, reg registry, policy RepeatPolicy,
+) (string, bool, []string, error) {
 	found := false
 	value := ""
-	cleanedArgs := make([]string, 0, len(args))
 	err := error(nil)
 
 	pushErr := func(newErr error) {
@@ -108,38 +236,36 @@ func (a argFlag) value(args []string) (string, bool, []string, error) {
 		}
 	}
 
-	for i, mi := 0, len(args); i < mi; i++ {
-		if a.argIs(args[i]) { //nolint:nestif // Ok.
-			if (i + 1) >= mi {
-				pushErr(
-					fmt.Errorf(
-						"%w: '%s'",
-						ErrMissing,
-						a,
-					),
-				)
-			} else {
-				i++
-				if found {
-					pushErr(
-						fmt.Errorf(
-							"%w: '%s' for '%s' already set to: '%s'",
-							ErrAmbiguous,
-							a,
-							args[i],
-							value,
-						),
-					)
-				} else {
-					value = args[i]
-					found = true
-				}
-			}
-		} else {
-			cleanedArgs = append(cleanedArgs, args[i])
+	occurrences := a.find(args, singleValue, reg)
+
+	for _, occ := range policy.apply(occurrences) {
+		switch {
+		case !occ.hasValue:
+			pushErr(
+				fmt.Errorf(
+					"%w: '%s'",
+					ErrMissing,
+					a,
+				),
+			)
+		case found:
+			pushErr(
+				fmt.Errorf(
+					"%w: '%s' for '%s' already set to: '%s'",
+					ErrAmbiguous,
+					a,
+					occ.value,
+					value,
+				),
+			)
+		default:
+			value = occ.value
+			found = true
 		}
 	}
 
+	cleanedArgs := removeOccurrences(args, occurrences)
+
 	if err == nil {
 		return value, found, cleanedArgs, nil
 	}
@@ -147,31 +273,120 @@ func (a argFlag) value(args []string) (string, bool, []string, error) {
 	return "", false, cleanedArgs, err
 }
 
-// Values scans the args looking for all instances of the specified flag.  If
-// it finds it then the next arg as the value absorbing both the flag the
-// value from the argument list.
-func (a argFlag) values(args []string) ([]string, []string, error) {
+// optional scans the args looking for the specified flag whose value is
+// optional.  The value can only be attached to the flag itself (IE:
+// --color=auto or -cauto) so a following argument is never absorbed.  It
+// returns the value, a boolean indicating if a value was attached and a
+// boolean indicating if the flag was found.  If the flag appears more than
+// once (unless permitted by the policy) an error is returned.
+func (a argFlag) optional(
+	args []string, reg registry, policy RepeatPolicy,
+) (string, bool, bool, []string, error) {
+	found := a.find(args, optionalValue, reg)
+	cleanedArgs := removeOccurrences(args, found)
+	found = policy.apply(found)
+
+	if len(found) > 1 {
+		return "", false, false, cleanedArgs,
+			fmt.Errorf(
+				"%w: '%s' found %d times",
+				ErrAmbiguous,
+				a,
+				len(found),
+			)
+	}
+
+	if len(found) == 0 {
+		return "", false, false, cleanedArgs, nil
+	}
+
+	return found[0].value, found[0].hasValue, true, cleanedArgs, nil
+}
+
+// tuple scans the args looking for the specified flag followed by exactly n
+// values absorbing both the flag and its values from the argument list.  If
+// fewer than n values follow the flag or the flag appears more than once
+// (unless permitted by the policy) an error is returned.
+func (a argFlag) tuple(
+	args []string, n int, reg registry, policy RepeatPolicy,
+) ([]string, []string, error) {
+	found := false
 	values := []string(nil)
-	cleanedArgs := make([]string, 0, len(args))
 	err := error(nil)
 
-	for i, mi := 0, len(args); i < mi; i++ {
-		if a.argIs(args[i]) {
-			if (i + 1) >= mi {
-				err = fmt.Errorf(
-					"%w: '%s'",
+	pushErr := func(newErr error) {
+		if err == nil {
+			err = newErr
+		} else {
+			err = fmt.Errorf("%w: %w", err, newErr)
+		}
+	}
+
+	occurrences := a.find(args, n, reg)
+
+	for _, occ := range policy.apply(occurrences) {
+		switch {
+		case !occ.hasValue:
+			pushErr(
+				fmt.Errorf(
+					"%w: '%s' expects %d values but found %d",
 					ErrMissing,
 					a,
-				)
-			} else {
-				i++
-				values = append(values, args[i])
-			}
+					n,
+					len(occ.values),
+				),
+			)
+		case found:
+			pushErr(
+				fmt.Errorf(
+					"%w: '%s' for %v already set to: %v",
+					ErrAmbiguous,
+					a,
+					occ.values,
+					values,
+				),
+			)
+		default:
+			values = occ.values
+			found = true
+		}
+	}
+
+	cleanedArgs := removeOccurrences(args, occurrences)
+
+	if err == nil {
+		return values, cleanedArgs, nil
+	}
+
+	return nil, cleanedArgs, err
+}
+
+// Values scans the args looking for all instances of the specified flag.  If
+// it finds it then the next arg (or the value attached to the flag itself) is
+// taken as the value absorbing both the flag the value from the argument
+// list.
+func (a argFlag) values(
+	args []string, reg registry,
+) ([]string, []string, error) {
+	values := []string(nil)
+	err := error(nil)
+
+	occurrences := a.find(args, singleValue, reg)
+
+	for _, occ := range occurrences {
+		if occ.hasValue {
+			values = append(values, occ.value)
 		} else {
-			cleanedArgs = append(cleanedArgs, args[i])
+			err = fmt.Errorf(
+				"%w: '%s'",
+				ErrMissing,
+				a,
+			)
 		}
 	}
 
+	cleanedArgs := removeOccurrences(args, occurrences)
+
 	if err == nil {
 		return values, cleanedArgs, nil
 	}
@@ -34,27 +34,27 @@ func TestSzArgs_ArgCount(t *testing.T) {
 		args  []string
 	)
 
-	count, args = arg.count(args)
+	count, args = arg.count(args, nil)
 	chk.Int(count, 0)
 	chk.StrSlice(args, nil)
 
-	count, args = arg.count([]string{"arg1", "arg2"})
+	count, args = arg.count([]string{"arg1", "arg2"}, nil)
 	chk.Int(count, 0)
 	chk.StrSlice(args, []string{"arg1", "arg2"})
 
-	count, args = arg.count([]string{"-v", "arg1", "arg2"})
+	count, args = arg.count([]string{"-v", "arg1", "arg2"}, nil)
 	chk.Int(count, 1)
 	chk.StrSlice(args, []string{"arg1", "arg2"})
 
-	count, args = arg.count([]string{"arg1", "-v", "arg2"})
+	count, args = arg.count([]string{"arg1", "-v", "arg2"}, nil)
 	chk.Int(count, 1)
 	chk.StrSlice(args, []string{"arg1", "arg2"})
 
-	count, args = arg.count([]string{"arg1", "arg2", "--verbose"})
+	count, args = arg.count([]string{"arg1", "arg2", "--verbose"}, nil)
 	chk.Int(count, 1)
 	chk.StrSlice(args, []string{"arg1", "arg2"})
 
-	count, args = arg.count([]string{"-v", "arg1", "-v", "arg2", "-v"})
+	count, args = arg.count([]string{"-v", "arg1", "-v", "arg2", "-v"}, nil)
 	chk.Int(count, 3)
 	chk.StrSlice(args, []string{"arg1", "arg2"})
 
@@ -72,53 +72,74 @@ func TestSzArgs_ArgCount(t *testing.T) {
 			"-v",
 			"--verbose",
 		},
+		nil,
 	)
 	chk.Int(count, 9)
 	chk.StrSlice(args, []string{"arg1", "arg2"})
 }
 
-func TestSzArgs_Is(t *testing.T) {
+func TestSzArgs_Polarity(t *testing.T) {
 	chk := sztestlog.CaptureNothing(t)
 	defer chk.Release()
 
 	var (
-		err   error
-		arg   = argFlag("-v")
-		found bool
-		args  []string
+		err    error
+		arg    = argFlag("-v")
+		result bool
+		found  bool
+		args   []string
 	)
 
-	found, args, err = arg.is(nil)
+	result, found, args, err = arg.polarity(nil, nil, RepeatError)
+	chk.False(result)
 	chk.False(found)
 	chk.StrSlice(args, nil)
 	chk.NoErr(err)
 
-	found, args, err = arg.is([]string{"arg1"})
+	result, found, args, err = arg.polarity(
+		[]string{"arg1"}, nil, RepeatError,
+	)
+	chk.False(result)
 	chk.False(found)
 	chk.StrSlice(args, []string{"arg1"})
 	chk.NoErr(err)
 
-	found, args, err = arg.is([]string{"arg1", "arg2"})
+	result, found, args, err = arg.polarity(
+		[]string{"arg1", "arg2"}, nil, RepeatError,
+	)
+	chk.False(result)
 	chk.False(found)
 	chk.StrSlice(args, []string{"arg1", "arg2"})
 	chk.NoErr(err)
 
-	found, args, err = arg.is([]string{"-v", "arg1", "arg2"})
+	result, found, args, err = arg.polarity(
+		[]string{"-v", "arg1", "arg2"}, nil, RepeatError,
+	)
+	chk.True(result)
 	chk.True(found)
 	chk.StrSlice(args, []string{"arg1", "arg2"})
 	chk.NoErr(err)
 
-	found, args, err = arg.is([]string{"arg1", "-v", "arg2"})
+	result, found, args, err = arg.polarity(
+		[]string{"arg1", "-v", "arg2"}, nil, RepeatError,
+	)
+	chk.True(result)
 	chk.True(found)
 	chk.StrSlice(args, []string{"arg1", "arg2"})
 	chk.NoErr(err)
 
-	found, args, err = arg.is([]string{"arg1", "arg2", "-v"})
+	result, found, args, err = arg.polarity(
+		[]string{"arg1", "arg2", "-v"}, nil, RepeatError,
+	)
+	chk.True(result)
 	chk.True(found)
 	chk.StrSlice(args, []string{"arg1", "arg2"})
 	chk.NoErr(err)
 
-	found, args, err = arg.is([]string{"-v", "arg1", "arg2", "-v"})
+	result, found, args, err = arg.polarity(
+		[]string{"-v", "arg1", "arg2", "-v"}, nil, RepeatError,
+	)
+	chk.False(result)
 	chk.False(found)
 	chk.StrSlice(args, []string{"arg1", "arg2"})
 	chk.Err(
@@ -131,7 +152,9 @@ func TestSzargs_ValueNonePresent(t *testing.T) {
 	chk := sztestlog.CaptureNothing(t)
 	defer chk.Release()
 
-	value, found, args, err := argFlag("[-n theName]").value(nil)
+	value, found, args, err := argFlag("[-n theName]").value(
+		nil, nil, RepeatError,
+	)
 
 	chk.Str(value, "")
 	chk.False(found)
@@ -140,6 +163,8 @@ func TestSzargs_ValueNonePresent(t *testing.T) {
 
 	value, found, args, err = argFlag("[-n theName]").value(
 		[]string{"arg1", "arg2"},
+		nil,
+		RepeatError,
 	)
 
 	chk.Str(value, "")
@@ -156,6 +181,8 @@ func TestSzargs_ValueBeginning(t *testing.T) {
 
 	value, found, args, err := argFlag("[-n | --name <all|name>]").value(
 		[]string{"--name", "theName"},
+		nil,
+		RepeatError,
 	)
 
 	chk.Str(value, "theName")
@@ -165,6 +192,8 @@ func TestSzargs_ValueBeginning(t *testing.T) {
 
 	value, found, args, err = argFlag("-n").value(
 		[]string{"-n", "theName", "arg1", "arg2"},
+		nil,
+		RepeatError,
 	)
 
 	chk.Str(value, "theName")
@@ -179,6 +208,8 @@ func TestSzargs_ValueMiddle(t *testing.T) {
 
 	value, found, args, err := argFlag("-n").value(
 		[]string{"arg1", "-n", "theName", "arg2"},
+		nil,
+		RepeatError,
 	)
 
 	chk.Str(value, "theName")
@@ -193,6 +224,8 @@ func TestSzargs_ValueEnd(t *testing.T) {
 
 	value, found, args, err := argFlag("-n").value(
 		[]string{"arg1", "arg2", "-n", "theName"},
+		nil,
+		RepeatError,
 	)
 
 	chk.Str(value, "theName")
@@ -207,6 +240,8 @@ func TestSzargs_ValueDuplicate(t *testing.T) {
 
 	value, found, args, err := argFlag("-n").value(
 		[]string{"-n", "firstName", "arg1", "arg2", "-n", "secondName"},
+		nil,
+		RepeatError,
 	)
 
 	chk.Str(value, "")
@@ -234,6 +269,8 @@ func TestSzargs_ValueTriplicate(t *testing.T) {
 			"arg2",
 			"-n", "thirdName",
 		},
+		nil,
+		RepeatError,
 	)
 
 	chk.Str(value, "")
@@ -259,6 +296,8 @@ func TestSzargs_ValueMissing(t *testing.T) {
 
 	value, found, args, err := argFlag("-n value").value(
 		[]string{"arg1", "arg2", "-n"},
+		nil,
+		RepeatError,
 	)
 
 	chk.Str(value, "")
@@ -278,7 +317,7 @@ func TestSzargs_ValuesNonePresent(t *testing.T) {
 	chk := sztestlog.CaptureNothing(t)
 	defer chk.Release()
 
-	value, args, err := argFlag("-n").values(nil)
+	value, args, err := argFlag("-n").values(nil, nil)
 
 	chk.StrSlice(value, nil)
 	chk.StrSlice(args, nil)
@@ -286,6 +325,7 @@ func TestSzargs_ValuesNonePresent(t *testing.T) {
 
 	value, args, err = argFlag("-n").values(
 		[]string{"arg1", "arg2"},
+		nil,
 	)
 
 	chk.StrSlice(value, nil)
@@ -301,6 +341,7 @@ func TestSzargs_ValuesAtBeginning(t *testing.T) {
 
 	value, args, err := argFlag("-n").values(
 		[]string{"-n", "theName"},
+		nil,
 	)
 
 	chk.StrSlice(value, []string{"theName"})
@@ -309,6 +350,7 @@ func TestSzargs_ValuesAtBeginning(t *testing.T) {
 
 	value, args, err = argFlag("-n").values(
 		[]string{"-n", "theName", "arg1", "arg2"},
+		nil,
 	)
 
 	chk.StrSlice(value, []string{"theName"})
@@ -322,6 +364,7 @@ func TestSzargs_ValuesMiddle(t *testing.T) {
 
 	value, args, err := argFlag("-n").values(
 		[]string{"arg1", "-n", "theName", "arg2"},
+		nil,
 	)
 
 	chk.StrSlice(value, []string{"theName"})
@@ -335,6 +378,7 @@ func TestSzargs_ValuesEnd(t *testing.T) {
 
 	value, args, err := argFlag("-n").values(
 		[]string{"arg1", "arg2", "-n", "theName"},
+		nil,
 	)
 
 	chk.StrSlice(value, []string{"theName"})
@@ -348,6 +392,7 @@ func TestSzargs_ValuesDuplicate(t *testing.T) {
 
 	value, args, err := argFlag("-n").values(
 		[]string{"-n", "firstName", "arg1", "arg2", "-n", "secondName"},
+		nil,
 	)
 
 	chk.StrSlice(value, []string{"firstName", "secondName"})
@@ -364,6 +409,7 @@ func TestSzargs_ValuesMissing(t *testing.T) {
 
 	value, args, err := argFlag("-n value").values(
 		[]string{"arg1", "arg2", "-n"},
+		nil,
 	)
 
 	chk.StrSlice(value, nil)
@@ -377,3 +423,104 @@ func TestSzargs_ValuesMissing(t *testing.T) {
 			": '-n value'",
 	)
 }
+
+func TestSzargs_ValueAttached(t *testing.T) {
+	chk := sztestlog.CaptureNothing(t)
+	defer chk.Release()
+
+	value, found, args, err := argFlag("[-n | --name theName]").value(
+		[]string{"arg1", "--name=theName", "arg2"},
+		nil,
+		RepeatError,
+	)
+
+	chk.Str(value, "theName")
+	chk.True(found)
+	chk.StrSlice(args, []string{"arg1", "arg2"})
+	chk.NoErr(err)
+
+	value, found, args, err = argFlag("[-n | --name theName]").value(
+		[]string{"arg1", "-ntheName", "arg2"},
+		nil,
+		RepeatError,
+	)
+
+	chk.Str(value, "theName")
+	chk.True(found)
+	chk.StrSlice(args, []string{"arg1", "arg2"})
+	chk.NoErr(err)
+
+	value, found, args, err = argFlag("[-n | --name theName]").value(
+		[]string{"--name=", "arg1"},
+		nil,
+		RepeatError,
+	)
+
+	chk.Str(value, "")
+	chk.True(found)
+	chk.StrSlice(args, []string{"arg1"})
+	chk.NoErr(err)
+
+	value, found, args, err = argFlag("[-n | --name theName]").value(
+		[]string{"--names", "-", "--nameless=x"},
+		nil,
+		RepeatError,
+	)
+
+	chk.Str(value, "")
+	chk.False(found)
+	chk.StrSlice(args, []string{"--names", "-", "--nameless=x"})
+	chk.NoErr(err)
+}
+
+func TestSzargs_ValueAttachedDuplicate(t *testing.T) {
+	chk := sztestlog.CaptureNothing(t)
+	defer chk.Release()
+
+	value, found, args, err := argFlag("[-n | --name theName]").value(
+		[]string{"-nfirstName", "arg1", "--name", "secondName"},
+		nil,
+		RepeatError,
+	)
+
+	chk.Str(value, "")
+	chk.False(found)
+	chk.StrSlice(args, []string{"arg1"})
+	chk.Err(
+		err,
+		ErrAmbiguous.Error()+
+			": '[-n | --name theName]' for 'secondName' "+
+			"already set to: 'firstName'",
+	)
+}
+
+func TestSzargs_ValuesAttached(t *testing.T) {
+	chk := sztestlog.CaptureNothing(t)
+	defer chk.Release()
+
+	value, args, err := argFlag("[-n | --name theName]").values(
+		[]string{
+			"-nfirst", "arg1", "--name=second", "-n", "third", "arg2",
+		},
+		nil,
+	)
+
+	chk.StrSlice(value, []string{"first", "second", "third"})
+	chk.StrSlice(args, []string{"arg1", "arg2"})
+	chk.NoErr(err)
+}
+
+func TestSzargs_NegatedNames(t *testing.T) {
+	chk := sztestlog.CaptureNothing(t)
+	defer chk.Release()
+
+	arg := argFlag("[-c | --[no-]color]")
+
+	chk.StrSlice(arg.names(), []string{"-c", "--color"})
+	chk.StrSlice(arg.negatedNames(), []string{"--no-color"})
+	chk.StrSlice(arg.allNames(), []string{"-c", "--color", "--no-color"})
+	chk.True(arg.argIs("--color"))
+	chk.False(arg.argIs("--no-color"))
+	chk.True(arg.argIsNegated("--no-color"))
+	chk.StrSlice(argFlag("[-v]").negatedNames(), nil)
+}
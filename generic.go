@@ -0,0 +1,438 @@
+/*
+   Szerszam argument library: szargs.
+   Copyright (C) 2024-2025  Leslie Dancsecs
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU General Public License for more details.
+
+   You should have received a copy of the GNU General Public License
+   along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package szargs
+
+import (
+	"errors"
+	"fmt"
+)
+
+// parse parses the string with the parser noting the environment variable
+// the string was provided by (if any) in an error.
+func parse[T any](
+	args *Args, parser Parser[T], name, str string, from origin,
+) (T, error) {
+	result, err := parser.Parse(name, str)
+
+	return result, args.noteOrigin(err, from)
+}
+
+// parseEach parses each of the strings with the parser.  Every value is
+// parsed with all errors joined together.
+func parseEach[T any](
+	args *Args, parser Parser[T], name string, list []string,
+	origins []origin,
+) ([]T, error) {
+	var (
+		item   T
+		result = make([]T, len(list))
+		err    error
+	)
+
+	for i, str := range list {
+		var parseErr error
+
+		item, parseErr = parse(args, parser, name, str, origins[i])
+		if parseErr != nil {
+			if err == nil {
+				err = parseErr
+			} else {
+				err = fmt.Errorf("%w: %w", err, parseErr)
+			}
+		} else {
+			result[i] = item
+		}
+	}
+
+	return result, err
+}
+
+// Value scans for a specific flagged argument and parses its value with the
+// provided parser. The flag and its value are removed from the argument list.
+//
+// If the flag appears more than once, lacks a following value, or if the
+// parser rejects the value, an error is registered.
+//
+// Returns the parsed value and a boolean indicating whether the flag was
+// found.
+func Value[T any](args *Args, parser Parser[T], flag, desc string) (T, bool) {
+	var (
+		arg    string
+		from   origin
+		found  bool
+		result T
+		err    error
+	)
+
+	args.RegisterUsage(flag, desc)
+
+	arg, from, found, err = args.scanValue(flag)
+
+	if err == nil && found {
+		result, err = parse(args, parser, flag, arg, from)
+		if err != nil {
+			found = false
+		}
+	}
+
+	args.PushErr(err)
+
+	return result, found
+}
+
+// ValueOptional scans for a specific flagged argument whose value is optional
+// (e.g., "--level" or "--level=3") and parses any attached value with the
+// provided parser. The flag is removed from the argument list. A following
+// argument is never absorbed as the value.
+//
+// If the flag appears more than once, or if the parser rejects the attached
+// value, an error is registered.
+//
+// Returns the parsed value (or implied if no value was attached) and a boolean
+// indicating whether the flag was found.
+func ValueOptional[T any](
+	args *Args, parser Parser[T], flag string, implied T, desc string,
+) (T, bool) {
+	var (
+		arg      string
+		from     origin
+		hasValue bool
+		found    bool
+		result   T
+		err      error
+	)
+
+	args.RegisterUsage(flag, desc)
+
+	arg, from, hasValue, found, err = args.scanOptional(flag)
+
+	if err == nil && found && !hasValue {
+		result = implied
+	}
+
+	if err == nil && hasValue {
+		result, err = parse(args, parser, flag, arg, from)
+		if err != nil {
+			found = false
+		}
+	}
+
+	args.PushErr(err)
+
+	return result, found
+}
+
+// ValueN scans for a specific flagged argument followed by exactly n values
+// (e.g., "--point 3 4") and parses each with the provided parser. The flag
+// and its values are removed from the argument list.
+//
+// If the flag appears more than once, if fewer than n values follow it, or if
+// the parser rejects a value, an error is registered.
+//
+// Returns the n parsed values or nil if the flag was not found.
+func ValueN[T any](
+	args *Args, parser Parser[T], flag string, n int, desc string,
+) []T {
+	var (
+		matches []string
+		from    origin
+		result  []T
+		err     error
+	)
+
+	args.RegisterUsage(flag, desc)
+
+	matches, from, err = args.scanTuple(flag, n)
+
+	if err == nil && len(matches) > 0 {
+		origins := make([]origin, len(matches))
+		for i := range origins {
+			origins[i] = from
+		}
+
+		result, err = parseEach(args, parser, flag, matches, origins)
+	}
+
+	args.PushErr(err)
+
+	if err == nil {
+		return result
+	}
+
+	return nil
+}
+
+// Values scans for repeated instances of the specified flag and parses the
+// following values with the provided parser. The flags and values are removed
+// from the argument list.
+//
+// If any flag lacks a following value, or if the parser rejects a value, an
+// error is registered.
+//
+// Returns a slice of the parsed values.
+func Values[T any](args *Args, parser Parser[T], flag, desc string) []T {
+	var (
+		matches []string
+		origins []origin
+		result  []T
+		err     error
+	)
+
+	args.RegisterUsage(flag, desc)
+
+	matches, origins, err = args.scanValues(flag)
+
+	if err == nil {
+		result, err = parseEach(args, parser, flag, matches, origins)
+	}
+
+	args.PushErr(err)
+
+	if err == nil {
+		return result
+	}
+
+	return nil
+}
+
+// Setting returns a configuration value based on a default, optionally
+// overridden by an environment variable, and further overridden by a flagged
+// command-line argument. The environment or flag value is parsed with the
+// provided parser while the default is returned as is.
+//
+// If the parser rejects the final value, an error is registered.
+//
+// Returns the final value.
+func Setting[T any](
+	args *Args, parser Parser[T], flag, env string, def T, desc string,
+) T {
+	var (
+		value     string
+		from      origin
+		result    T
+		parseName string
+		srcErr    error
+		err       error
+	)
+
+	args.RegisterUsage(flag, desc)
+
+	value, from, srcErr, err = args.scanSetting(flag, env, defaultStandIn)
+
+	if err == nil { //nolint:nestif // Ok.
+		if value == defaultStandIn {
+			result = def
+		} else {
+			if errors.Is(srcErr, ErrInvalidEnv) {
+				parseName = env
+			} else {
+				parseName = flag
+			}
+
+			result, err = parse(args, parser, parseName, value, from)
+		}
+	}
+
+	if err != nil {
+		args.PushErr(srcErr)
+		args.PushErr(err)
+	}
+
+	return result
+}
+
+// SettingValues returns a list of configuration values based on a default,
+// optionally overridden by an environment variable, and further overridden by
+// repeated instances of a flagged command-line argument. The environment
+// variable and flagged values are split using the list separator (if one has
+// been set) and each value is parsed with the provided parser while the
+// default is returned as is.
+//
+// If the parser rejects any final value, an error is registered.
+//
+// Returns the final parsed values.
+func SettingValues[T any](
+	args *Args, parser Parser[T], flag, env string, def []T, desc string,
+) []T {
+	var (
+		values    []string
+		origins   []origin
+		found     bool
+		result    []T
+		parseName string
+		srcErr    error
+		err       error
+	)
+
+	args.RegisterUsage(flag, desc)
+
+	values, origins, found, srcErr, err = args.settingValues(flag, env)
+
+	if err == nil && !found {
+		return def
+	}
+
+	if err == nil {
+		if errors.Is(srcErr, ErrInvalidEnv) {
+			parseName = env
+		} else {
+			parseName = flag
+		}
+
+		result, err = parseEach(args, parser, parseName, values, origins)
+	}
+
+	if err != nil {
+		args.PushErr(srcErr)
+		args.PushErr(err)
+
+		return nil
+	}
+
+	return result
+}
+
+// Next removes the next argument from the argument list and parses it with
+// the provided parser.
+//
+// If no arguments remain, or if the parser rejects the value, an error is
+// registered.
+//
+// Returns the parsed value.
+func Next[T any](args *Args, parser Parser[T], name, desc string) T {
+	var (
+		arg    string
+		from   origin
+		result T
+		err    error
+	)
+
+	args.RegisterUsage(name, desc)
+
+	arg, from, err = args.nextArg(name)
+
+	if err == nil {
+		result, err = parse(args, parser, name, arg, from)
+	}
+
+	args.PushErr(err)
+
+	return result
+}
+
+// NextOr removes the next argument from the argument list and parses it with
+// the provided parser or returns the default if no arguments remain.
+//
+// If the parser rejects the value, an error is registered.
+//
+// Returns the parsed value or the default.
+func NextOr[T any](
+	args *Args, parser Parser[T], name string, def T, desc string,
+) T {
+	var (
+		arg    string
+		from   origin
+		result T
+		err    error
+	)
+
+	args.registerOptional(name, fmt.Sprint(def), desc)
+
+	if !args.HasNext() {
+		return def
+	}
+
+	arg, from, err = args.nextArg(name)
+
+	if err == nil {
+		result, err = parse(args, parser, name, arg, from)
+	}
+
+	args.PushErr(err)
+
+	return result
+}
+
+// Last removes the last argument from the argument list and parses it with
+// the provided parser. Taking the last argument first supports synopses
+// ending with a fixed argument following a variable number of arguments (IE:
+// "cp SRC... DEST").
+//
+// If no arguments remain, or if the parser rejects the value, an error is
+// registered.
+//
+// Returns the parsed value.
+func Last[T any](args *Args, parser Parser[T], name, desc string) T {
+	var (
+		arg    string
+		from   origin
+		result T
+		err    error
+	)
+
+	args.RegisterUsage(name, desc)
+
+	arg, from, err = args.lastArg(name)
+
+	if err == nil {
+		result, err = parse(args, parser, name, arg, from)
+	}
+
+	args.PushErr(err)
+
+	return result
+}
+
+// Rest removes all remaining arguments from the argument list (including any
+// read from the argument stream) and parses each with the provided parser. An
+// end of options marker ("--") not yet consumed is discarded.
+//
+// If fewer than minCount arguments remain an ErrMissing error is registered.
+// If more than maxCount arguments remain an ErrUnexpected error is registered.
+// A negative maxCount imposes no upper limit. If the parser rejects any
+// argument, an error is registered.
+//
+// Returns the parsed values.
+func Rest[T any](
+	args *Args, parser Parser[T], name string, minCount, maxCount int,
+	desc string,
+) []T {
+	var (
+		matches []string
+		origins []origin
+		result  []T
+		err     error
+	)
+
+	args.RegisterUsage(name, desc)
+
+	matches, origins, err = args.restArgs(name, minCount, maxCount)
+
+	if err == nil && len(matches) > 0 {
+		result, err = parseEach(args, parser, name, matches, origins)
+	}
+
+	args.PushErr(err)
+
+	if err == nil {
+		return result
+	}
+
+	return nil
+}
@@ -0,0 +1,361 @@
+/*
+   Szerszam argument library: szargs.
+   Copyright (C) 2024-2025  Leslie Dancsecs
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU General Public License for more details.
+
+   You should have received a copy of the GNU General Public License
+   along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package szargs_test
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/dancsecs/szargs"
+	"github.com/dancsecs/sztestlog"
+)
+
+var errTstPoint = errors.New("invalid point")
+
+type tstPoint struct {
+	x, y int
+}
+
+type tstPointParser struct{}
+
+func (tstPointParser) Parse(name, str string) (tstPoint, error) {
+	xStr, yStr, found := strings.Cut(str, ",")
+	if found {
+		x, xErr := strconv.Atoi(xStr)
+		y, yErr := strconv.Atoi(yStr)
+
+		if xErr == nil && yErr == nil {
+			return tstPoint{x: x, y: y}, nil
+		}
+	}
+
+	return tstPoint{x: 0, y: 0},
+		fmt.Errorf("%w: %s: '%s'", errTstPoint, name, str)
+}
+
+func TestSzargs_Generic_Value(t *testing.T) {
+	chk := sztestlog.CaptureNothing(t)
+	defer chk.Release()
+
+	args := szargs.New("program description", []string{
+		"programName",
+		"--origin", "3,4",
+		"-n", "0x10",
+		"arg",
+	})
+
+	origin, found := szargs.Value(
+		args, tstPointParser{}, "[--origin x,y]", "the origin",
+	)
+
+	chk.True(found)
+	chk.Int(origin.x, 3)
+	chk.Int(origin.y, 4)
+
+	num, found := szargs.Value(args, szargs.ParseInt8, "[-n num]", "a number")
+
+	chk.True(found)
+	chk.Int(int(num), 16)
+
+	missing, found := szargs.Value(
+		args, szargs.ParseString, "[-m str]", "missing",
+	)
+
+	chk.False(found)
+	chk.Str(missing, "")
+
+	chk.NoErr(args.Err())
+	chk.StrSlice(args.Args(), []string{"arg"})
+}
+
+func TestSzargs_Generic_ValueInvalid(t *testing.T) {
+	chk := sztestlog.CaptureNothing(t)
+	defer chk.Release()
+
+	args := szargs.New("program description", []string{
+		"programName",
+		"--origin", "3:4",
+	})
+
+	origin, found := szargs.Value(
+		args, tstPointParser{}, "[--origin x,y]", "the origin",
+	)
+
+	chk.False(found)
+	chk.Int(origin.x, 0)
+	chk.Err(
+		args.Err(),
+		chk.ErrChain(
+			errTstPoint,
+			"[--origin x,y]",
+			"'3:4'",
+		),
+	)
+}
+
+func TestSzargs_Generic_Values(t *testing.T) {
+	chk := sztestlog.CaptureNothing(t)
+	defer chk.Release()
+
+	args := szargs.New("program description", []string{
+		"programName",
+		"-p", "1,2",
+		"-p", "3,4",
+		"-f", "yml",
+		"-f", "json",
+	})
+
+	points := szargs.Values(args, tstPointParser{}, "[-p x,y ...]", "points")
+
+	chk.Int(len(points), 2)
+	chk.Int(points[1].x, 3)
+	chk.Int(points[1].y, 4)
+
+	formats := szargs.Values(
+		args,
+		szargs.OptionParser(
+			[]string{"json", "yaml|yml"}, szargs.OptionAliases,
+		),
+		"[-f fmt ...]",
+		"formats",
+	)
+
+	chk.StrSlice(formats, []string{"yaml", "json"})
+	chk.NoErr(args.Err())
+
+	args = szargs.New("program description", []string{
+		"programName",
+		"-p", "1",
+		"-p", "2,3",
+		"-p", "x",
+	})
+
+	points = szargs.Values(args, tstPointParser{}, "[-p x,y ...]", "points")
+
+	chk.Int(len(points), 0)
+	chk.Err(
+		args.Err(),
+		chk.ErrChain(
+			errTstPoint,
+			"[-p x,y ...]",
+			"'1'",
+			errTstPoint.Error()+": [-p x,y ...]",
+			"'x'",
+		),
+	)
+}
+
+func TestSzargs_Generic_Setting(t *testing.T) {
+	chk := sztestlog.CaptureNothing(t)
+	defer chk.Release()
+
+	def := tstPoint{x: 9, y: 9}
+
+	args := szargs.New("program description", []string{
+		"programName",
+	})
+
+	chk.Int(
+		szargs.Setting(args, tstPointParser{}, tstArgFlag, tstEnv, def, "p").x,
+		9,
+	)
+
+	chk.SetEnv(tstEnv, "5,6")
+
+	chk.Int(
+		szargs.Setting(args, tstPointParser{}, tstArgFlag, tstEnv, def, "p").x,
+		5,
+	)
+
+	args = szargs.New("program description", []string{
+		"programName",
+		tstArg, "7,8",
+	})
+
+	chk.Int(
+		szargs.Setting(args, tstPointParser{}, tstArgFlag, tstEnv, def, "p").y,
+		8,
+	)
+	chk.NoErr(args.Err())
+
+	chk.SetEnv(tstEnv, "bad")
+
+	result := szargs.Setting(
+		args, szargs.ParseUint, tstArgFlag, tstEnv, 3, "n",
+	)
+
+	chk.Uint(result, 0)
+	chk.Err(
+		args.Err(),
+		chk.ErrChain(
+			szargs.ErrInvalidEnv,
+			szargs.ErrInvalidUint,
+			szargs.ErrSyntax,
+			tstEnv,
+			"'bad'",
+		),
+	)
+}
+
+func TestSzargs_Generic_Next(t *testing.T) {
+	chk := sztestlog.CaptureNothing(t)
+	defer chk.Release()
+
+	args := szargs.New("program description", []string{
+		"programName",
+		"1,2",
+		"2.5",
+	})
+
+	point := szargs.Next(args, tstPointParser{}, "point", "a point")
+	scale := szargs.Next(args, szargs.ParseFloat64, "scale", "the scale")
+	count := szargs.Next(args, szargs.ParseInt, "count", "the count")
+
+	chk.Int(point.y, 2)
+	chk.Float64(scale, 2.5, 0)
+	chk.Int(count, 0)
+	chk.Err(
+		args.Err(),
+		chk.ErrChain(
+			szargs.ErrMissing,
+			"count",
+		),
+	)
+}
+
+func TestSzargs_Generic_ValueOptional(t *testing.T) {
+	chk := sztestlog.CaptureNothing(t)
+	defer chk.Release()
+
+	args := szargs.New("program description", []string{
+		"programName",
+		"--origin",
+		"--name=bob",
+	})
+
+	origin, found := szargs.ValueOptional(
+		args, tstPointParser{}, "[--origin[=x,y]]", tstPoint{x: 1, y: 2},
+		"the origin",
+	)
+
+	chk.True(found)
+	chk.Int(origin.x, 1)
+	chk.Int(origin.y, 2)
+
+	name, found := szargs.ValueOptional(
+		args, szargs.ParseString, "[--name[=name]]", "anonymous", "the name",
+	)
+
+	chk.True(found)
+	chk.Str(name, "bob")
+
+	chk.NoErr(args.Err())
+	chk.StrSlice(args.Args(), nil)
+}
+
+func TestSzargs_Generic_ValueN(t *testing.T) {
+	chk := sztestlog.CaptureNothing(t)
+	defer chk.Release()
+
+	args := szargs.New("program description", []string{
+		"programName",
+		"--line", "1,2", "3,4",
+	})
+
+	line := szargs.ValueN(
+		args, tstPointParser{}, "[--line from to]", 2, "a line",
+	)
+
+	chk.Int(len(line), 2)
+	chk.Int(line[0].x, 1)
+	chk.Int(line[1].y, 4)
+	chk.NoErr(args.Err())
+}
+
+func TestSzargs_Generic_SettingValues(t *testing.T) {
+	chk := sztestlog.CaptureNothing(t)
+	defer chk.Release()
+
+	args := szargs.New("program description", []string{
+		"programName",
+		"-p", "1,2",
+		"-p", "3,4",
+	})
+
+	points := szargs.SettingValues(
+		args, tstPointParser{}, "[-p x,y ...]", tstEnv, nil, "the points",
+	)
+
+	chk.Int(len(points), 2)
+	chk.Int(points[1].x, 3)
+	chk.NoErr(args.Err())
+}
+
+func TestSzargs_Generic_NextOr(t *testing.T) {
+	chk := sztestlog.CaptureNothing(t)
+	defer chk.Release()
+
+	args := szargs.New("program description", []string{
+		"programName",
+		"1,2",
+	})
+
+	point := szargs.NextOr(
+		args, tstPointParser{}, "point", tstPoint{x: 0, y: 0}, "a point",
+	)
+	name := szargs.NextOr(args, szargs.ParseString, "name", "bob", "a name")
+
+	chk.Int(point.y, 2)
+	chk.Str(name, "bob")
+	chk.NoErr(args.Err())
+}
+
+func TestSzargs_Generic_LastRest(t *testing.T) {
+	chk := sztestlog.CaptureNothing(t)
+	defer chk.Release()
+
+	args := szargs.New("program description", []string{
+		"programName",
+		"1,2",
+		"3,4",
+		"5,6",
+	})
+
+	dest := szargs.Last(args, tstPointParser{}, "DEST", "the destination")
+	sources := szargs.Rest(args, tstPointParser{}, "SRC...", 1, -1, "sources")
+
+	chk.Int(dest.x, 5)
+	chk.Int(len(sources), 2)
+	chk.Int(sources[1].x, 3)
+	chk.NoErr(args.Err())
+
+	sources = szargs.Rest(args, tstPointParser{}, "SRC...", 1, -1, "sources")
+
+	chk.Int(len(sources), 0)
+	chk.Err(
+		args.Err(),
+		chk.ErrChain(
+			szargs.ErrMissing,
+			"'SRC...' expects at least 1 values but found 0",
+		),
+	)
+}
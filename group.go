@@ -0,0 +1,64 @@
+/*
+   Szerszam argument library: szargs.
+   Copyright (C) 2024-2025  Leslie Dancsecs
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU General Public License for more details.
+
+   You should have received a copy of the GNU General Public License
+   along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package szargs
+
+// splitGroup expands a POSIX short option group (IE: -abc) into its
+// individual flags registered in the registry.  Expansion stops at the first
+// letter that is not a registered short flag leaving the remainder as its own
+// argument.  A registered flag taking a value absorbs the rest of the group
+// as its attached value.  If it is the last letter in the group then its
+// values must follow the group and their number is returned.
+func (args *Args) splitGroup(arg string, reg registry) ([]string, int) {
+	if len(arg) < 3 || arg[0] != '-' || arg[1] == '-' {
+		return []string{arg}, noValue
+	}
+
+	var parts []string
+
+	group := arg[1:]
+
+	for pos, letter := range group {
+		name := "-" + string(letter)
+
+		values, known := reg[name]
+		if !known {
+			if pos == 0 {
+				return []string{arg}, noValue
+			}
+
+			return append(parts, "-"+group[pos:]), noValue
+		}
+
+		switch {
+		case values == optionalValue:
+			return append(parts, "-"+group[pos:]), noValue
+		case values > noValue:
+			attached := group[pos:]
+			if attached == name[1:] {
+				return append(parts, name), values
+			}
+
+			return append(parts, "-"+attached), values - 1
+		}
+
+		parts = append(parts, name)
+	}
+
+	return parts, noValue
+}
@@ -0,0 +1,71 @@
+/*
+   Szerszam argument library: szargs.
+   Copyright (C) 2024-2025  Leslie Dancsecs
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU General Public License for more details.
+
+   You should have received a copy of the GNU General Public License
+   along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package szargs
+
+import (
+	"testing"
+
+	"github.com/dancsecs/sztestlog"
+)
+
+func TestSzargs_SplitGroup(t *testing.T) {
+	chk := sztestlog.CaptureNothing(t)
+	defer chk.Release()
+
+	args := New("description", []string{"programName"})
+	args.registerFlag("[-a | --all]", noValue)
+	args.registerFlag("[-b]", noValue)
+	args.registerFlag("[-n | --number num]", singleValue)
+
+	parts, values := args.splitGroup("-ab", args.flags)
+	chk.StrSlice(parts, []string{"-a", "-b"})
+	chk.Int(values, noValue)
+
+	parts, values = args.splitGroup("-abx", args.flags)
+	chk.StrSlice(parts, []string{"-a", "-b", "-x"})
+	chk.Int(values, noValue)
+
+	parts, values = args.splitGroup("-axb", args.flags)
+	chk.StrSlice(parts, []string{"-a", "-xb"})
+	chk.Int(values, noValue)
+
+	parts, values = args.splitGroup("-xab", args.flags)
+	chk.StrSlice(parts, []string{"-xab"})
+	chk.Int(values, noValue)
+
+	parts, values = args.splitGroup("-abn", args.flags)
+	chk.StrSlice(parts, []string{"-a", "-b", "-n"})
+	chk.Int(values, singleValue)
+
+	parts, values = args.splitGroup("-an5ab", args.flags)
+	chk.StrSlice(parts, []string{"-a", "-n5ab"})
+	chk.Int(values, noValue)
+
+	parts, values = args.splitGroup("--all", args.flags)
+	chk.StrSlice(parts, []string{"--all"})
+	chk.Int(values, noValue)
+
+	parts, values = args.splitGroup("-a", args.flags)
+	chk.StrSlice(parts, []string{"-a"})
+	chk.Int(values, noValue)
+
+	parts, values = args.splitGroup("-", args.flags)
+	chk.StrSlice(parts, []string{"-"})
+	chk.Int(values, noValue)
+}
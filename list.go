@@ -0,0 +1,133 @@
+/*
+   Szerszam argument library: szargs.
+   Copyright (C) 2024-2025  Leslie Dancsecs
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU General Public License for more details.
+
+   You should have received a copy of the GNU General Public License
+   along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package szargs
+
+import (
+	"fmt"
+	"strings"
+)
+
+// SetListSeparator sets the separator used to split a single value into a
+// list of values (IE: "," splits "-n 1,2,3" into 1, 2 and 3).  It applies to
+// all Values and SettingValues methods.  A separator may be included in a
+// value by escaping it with a backslash (IE: "a\,b") or by enclosing the
+// value in double quotes (IE: "\"a,b\"").  A backslash only escapes the
+// separator, a double quote or another backslash.  Any other backslash is
+// kept as is (IE: "C:\dir").  An empty separator (the default) disables
+// splitting.
+func (args *Args) SetListSeparator(sep string) {
+	args.listSeparator = sep
+}
+
+// splitList splits the string on the separator honoring backslash escapes
+// and double quotes.  An empty string results in an empty list.
+func splitList(name, str, sep string) ([]string, error) {
+	var (
+		items  []string
+		item   strings.Builder
+		quoted bool
+	)
+
+	if str == "" {
+		return nil, nil
+	}
+
+	for i := 0; i < len(str); {
+		switch {
+		case str[i] == '\\' && escapeLen(str[i+1:], sep) > 0:
+			n := escapeLen(str[i+1:], sep)
+			item.WriteString(str[i+1 : i+1+n])
+
+			i += 1 + n
+		case str[i] == '"':
+			quoted = !quoted
+			i++
+		case !quoted && strings.HasPrefix(str[i:], sep):
+			items = append(items, item.String())
+			item.Reset()
+
+			i += len(sep)
+		default:
+			item.WriteByte(str[i])
+
+			i++
+		}
+	}
+
+	if quoted {
+		return nil, fmt.Errorf(
+			"%w: %w: %s: '%s'", ErrInvalidList, ErrSyntax, name, str,
+		)
+	}
+
+	return append(items, item.String()), nil
+}
+
+// escapeLen returns the length of the escaped text at the start of str
+// following a backslash.  Only the separator, a double quote or another
+// backslash may be escaped.  Zero is returned if nothing is escaped.
+func escapeLen(str, sep string) int {
+	switch {
+	case strings.HasPrefix(str, `\`), strings.HasPrefix(str, `"`):
+		return 1
+	case strings.HasPrefix(str, sep):
+		return len(sep)
+	default:
+		return 0
+	}
+}
+
+// splitValues splits each value using the configured list separator.  Each
+// item split from a value shares the origin of the value.
+func (args *Args) splitValues(
+	name string, values []string, origins []origin,
+) ([]string, []origin, error) {
+	if args.listSeparator == "" {
+		return values, origins, nil
+	}
+
+	var (
+		result        []string
+		resultOrigins []origin
+		err           error
+	)
+
+	for i, value := range values {
+		items, splitErr := splitList(name, value, args.listSeparator)
+		if splitErr != nil {
+			if err == nil {
+				err = splitErr
+			} else {
+				err = fmt.Errorf("%w: %w", err, splitErr)
+			}
+		}
+
+		result = append(result, items...)
+
+		for range items {
+			resultOrigins = append(resultOrigins, origins[i])
+		}
+	}
+
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return result, resultOrigins, nil
+}
@@ -0,0 +1,74 @@
+/*
+   Szerszam argument library: szargs.
+   Copyright (C) 2024-2025  Leslie Dancsecs
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU General Public License for more details.
+
+   You should have received a copy of the GNU General Public License
+   along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package szargs
+
+import (
+	"testing"
+
+	"github.com/dancsecs/sztestlog"
+)
+
+func TestSzargs_SplitList(t *testing.T) {
+	chk := sztestlog.CaptureNothing(t)
+	defer chk.Release()
+
+	items, err := splitList("-n", "", ",")
+	chk.NoErr(err)
+	chk.StrSlice(items, nil)
+
+	items, err = splitList("-n", "a", ",")
+	chk.NoErr(err)
+	chk.StrSlice(items, []string{"a"})
+
+	items, err = splitList("-n", "a,b,,c", ",")
+	chk.NoErr(err)
+	chk.StrSlice(items, []string{"a", "b", "", "c"})
+
+	items, err = splitList("-n", `a\,b,"c,d",e\\f`, ",")
+	chk.NoErr(err)
+	chk.StrSlice(items, []string{"a,b", "c,d", `e\f`})
+
+	items, err = splitList("-n", "a::b::c", "::")
+	chk.NoErr(err)
+	chk.StrSlice(items, []string{"a", "b", "c"})
+
+	items, err = splitList("-n", `a,"b`, ",")
+	chk.Err(
+		err,
+		chk.ErrChain(
+			ErrInvalidList,
+			ErrSyntax,
+			"-n",
+			`'a,"b'`,
+		),
+	)
+	chk.StrSlice(items, nil)
+
+	items, err = splitList("-n", `a,b\`, ",")
+	chk.NoErr(err)
+	chk.StrSlice(items, []string{"a", `b\`})
+
+	items, err = splitList("-n", `C:\dir,C:\\x,a\"b\"`, ",")
+	chk.NoErr(err)
+	chk.StrSlice(items, []string{`C:\dir`, `C:\x`, `a"b"`})
+
+	items, err = splitList("-n", `a\::b\:c::d`, "::")
+	chk.NoErr(err)
+	chk.StrSlice(items, []string{`a::b\:c`, "d"})
+}
@@ -0,0 +1,110 @@
+/*
+   Szerszam argument library: szargs.
+   Copyright (C) 2024-2025  Leslie Dancsecs
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU General Public License for more details.
+
+   You should have received a copy of the GNU General Public License
+   along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package szargs
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// OptionMatch selects how a string is matched against a list of valid
+// options.  Modes may be combined (IE: OptionIgnoreCase | OptionPrefix).
+type OptionMatch uint8
+
+// Option matching modes.
+const (
+	// OptionExact matches options exactly.
+	OptionExact OptionMatch = 0
+	// OptionIgnoreCase matches options regardless of case.
+	OptionIgnoreCase OptionMatch = 1 << (iota - 1)
+	// OptionPrefix matches any unique prefix of an option.
+	OptionPrefix
+	// OptionAliases splits each option into alternate names separated by a
+	// vertical bar with the first being the canonical name returned (IE:
+	// "yaml|yml").
+	OptionAliases
+)
+
+// optionAliasSep separates the alternate names of a single option.
+const optionAliasSep = "|"
+
+// parseOption returns the canonical name of the valid option matching the
+// string using the provided matching modes.  An exact match is always
+// preferred over a prefix match.
+func parseOption(
+	name, str string, validOptions []string, match OptionMatch,
+) (string, error) {
+	normalize := func(s string) string {
+		if match&OptionIgnoreCase != 0 {
+			return strings.ToLower(s)
+		}
+
+		return s
+	}
+
+	var (
+		canonical  []string
+		candidates []string
+	)
+
+	for _, option := range validOptions {
+		names := []string{option}
+		if match&OptionAliases != 0 {
+			names = strings.Split(option, optionAliasSep)
+		}
+
+		canonical = append(canonical, names[0])
+
+		for _, optionName := range names {
+			if normalize(optionName) == normalize(str) {
+				return names[0], nil
+			}
+		}
+
+		if match&OptionPrefix != 0 && str != "" &&
+			!slices.Contains(candidates, names[0]) &&
+			slices.ContainsFunc(names, func(optionName string) bool {
+				return strings.HasPrefix(normalize(optionName), normalize(str))
+			}) {
+			candidates = append(candidates, names[0])
+		}
+	}
+
+	switch len(candidates) {
+	case 0:
+		return "", fmt.Errorf(
+			"%w: '%s' (%s must be one of %v)",
+			ErrInvalidOption,
+			str,
+			name,
+			canonical,
+		)
+	case 1:
+		return candidates[0], nil
+	default:
+		return "", fmt.Errorf(
+			"%w: '%s' (%s could be any of %v)",
+			ErrAmbiguous,
+			str,
+			name,
+			candidates,
+		)
+	}
+}
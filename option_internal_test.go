@@ -0,0 +1,181 @@
+/*
+   Szerszam argument library: szargs.
+   Copyright (C) 2024-2025  Leslie Dancsecs
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU General Public License for more details.
+
+   You should have received a copy of the GNU General Public License
+   along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package szargs
+
+import (
+	"testing"
+
+	"github.com/dancsecs/sztestlog"
+)
+
+func TestSzargs_ParseOption_Exact(t *testing.T) {
+	chk := sztestlog.CaptureNothing(t)
+	defer chk.Release()
+
+	options := []string{"json", "yaml|yml"}
+
+	result, err := parseOption("fmt", "json", options, OptionExact)
+	chk.NoErr(err)
+	chk.Str(result, "json")
+
+	result, err = parseOption("fmt", "yaml|yml", options, OptionExact)
+	chk.NoErr(err)
+	chk.Str(result, "yaml|yml")
+
+	result, err = parseOption("fmt", "yml", options, OptionExact)
+	chk.Err(
+		err,
+		chk.ErrChain(
+			ErrInvalidOption,
+			"'yml' (fmt must be one of [json yaml|yml])",
+		),
+	)
+	chk.Str(result, "")
+
+	result, err = parseOption("fmt", "JSON", options, OptionExact)
+	chk.Err(
+		err,
+		chk.ErrChain(
+			ErrInvalidOption,
+			"'JSON' (fmt must be one of [json yaml|yml])",
+		),
+	)
+	chk.Str(result, "")
+
+	result, err = parseOption("fmt", "js", options, OptionExact)
+	chk.Err(
+		err,
+		chk.ErrChain(
+			ErrInvalidOption,
+			"'js' (fmt must be one of [json yaml|yml])",
+		),
+	)
+	chk.Str(result, "")
+}
+
+func TestSzargs_ParseOption_Aliases(t *testing.T) {
+	chk := sztestlog.CaptureNothing(t)
+	defer chk.Release()
+
+	options := []string{"json", "yaml|yml"}
+
+	result, err := parseOption("fmt", "json", options, OptionAliases)
+	chk.NoErr(err)
+	chk.Str(result, "json")
+
+	result, err = parseOption("fmt", "yml", options, OptionAliases)
+	chk.NoErr(err)
+	chk.Str(result, "yaml")
+
+	result, err = parseOption("fmt", "toml", options, OptionAliases)
+	chk.Err(
+		err,
+		chk.ErrChain(
+			ErrInvalidOption,
+			"'toml' (fmt must be one of [json yaml])",
+		),
+	)
+	chk.Str(result, "")
+}
+
+func TestSzargs_ParseOption_IgnoreCase(t *testing.T) {
+	chk := sztestlog.CaptureNothing(t)
+	defer chk.Release()
+
+	options := []string{"c|celsius", "f|fahrenheit"}
+	match := OptionIgnoreCase | OptionAliases
+
+	result, err := parseOption("temp", "F", options, match)
+	chk.NoErr(err)
+	chk.Str(result, "f")
+
+	result, err = parseOption("temp", "Celsius", options, match)
+	chk.NoErr(err)
+	chk.Str(result, "c")
+
+	result, err = parseOption("temp", "k", options, match)
+	chk.Err(
+		err,
+		chk.ErrChain(
+			ErrInvalidOption,
+			"'k' (temp must be one of [c f])",
+		),
+	)
+	chk.Str(result, "")
+}
+
+func TestSzargs_ParseOption_Prefix(t *testing.T) {
+	chk := sztestlog.CaptureNothing(t)
+	defer chk.Release()
+
+	options := []string{"json", "jsonl", "yaml|yml", "text"}
+	match := OptionPrefix | OptionAliases
+
+	result, err := parseOption("fmt", "y", options, match)
+	chk.NoErr(err)
+	chk.Str(result, "yaml")
+
+	result, err = parseOption("fmt", "json", options, match)
+	chk.NoErr(err)
+	chk.Str(result, "json")
+
+	result, err = parseOption("fmt", "JSO", options, match)
+	chk.Err(
+		err,
+		chk.ErrChain(
+			ErrInvalidOption,
+			"'JSO' (fmt must be one of [json jsonl yaml text])",
+		),
+	)
+	chk.Str(result, "")
+
+	result, err = parseOption("fmt", "js", options, match)
+	chk.Err(
+		err,
+		chk.ErrChain(
+			ErrAmbiguous,
+			"'js' (fmt could be any of [json jsonl])",
+		),
+	)
+	chk.Str(result, "")
+
+	result, err = parseOption("fmt", "", options, match)
+	chk.Err(
+		err,
+		chk.ErrChain(
+			ErrInvalidOption,
+			"'' (fmt must be one of [json jsonl yaml text])",
+		),
+	)
+	chk.Str(result, "")
+
+	result, err = parseOption("fmt", "JSO", options, match|OptionIgnoreCase)
+	chk.Err(
+		err,
+		chk.ErrChain(
+			ErrAmbiguous,
+			"'JSO' (fmt could be any of [json jsonl])",
+		),
+	)
+	chk.Str(result, "")
+
+	result, err = parseOption("fmt", "ya", options, OptionPrefix)
+	chk.NoErr(err)
+	chk.Str(result, "yaml|yml")
+}
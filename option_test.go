@@ -0,0 +1,156 @@
+/*
+   Szerszam argument library: szargs.
+   Copyright (C) 2024-2025  Leslie Dancsecs
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU General Public License for more details.
+
+   You should have received a copy of the GNU General Public License
+   along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package szargs_test
+
+import (
+	"testing"
+
+	"github.com/dancsecs/szargs"
+	"github.com/dancsecs/sztestlog"
+)
+
+func TestSzargs_OptionMatching(t *testing.T) {
+	chk := sztestlog.CaptureNothing(t)
+	defer chk.Release()
+
+	args := szargs.New("program description", []string{
+		"programName",
+		"--temp", "F",
+		"--format", "YA",
+		"Celsius",
+	})
+
+	temps := []string{"c|celsius", "f|fahrenheit"}
+	tempMatch := szargs.OptionIgnoreCase | szargs.OptionAliases
+
+	temp, found := args.ValueOptionMatch(
+		"[--temp unit]", temps, tempMatch, "the unit",
+	)
+
+	chk.True(found)
+	chk.Str(temp, "f")
+
+	format := args.SettingOptionMatch(
+		"[--format fmt]",
+		"",
+		"json",
+		[]string{"json", "yaml|yml"},
+		szargs.OptionIgnoreCase|szargs.OptionPrefix|szargs.OptionAliases,
+		"the format",
+	)
+
+	chk.Str(format, "yaml")
+	chk.Str(args.NextOptionMatch("unit", temps, tempMatch, "the unit"), "c")
+
+	args.Done()
+
+	chk.NoErr(args.Err())
+}
+
+func TestSzargs_OptionMatching_Family(t *testing.T) {
+	chk := sztestlog.CaptureNothing(t)
+	defer chk.Release()
+
+	args := szargs.New("program description", []string{
+		"programName",
+		"-f", "JS",
+		"-f", "YA",
+		"--swap", "r", "B",
+		"--color=AL",
+		"--mode", "Fa",
+		"SL",
+		"Fa",
+		"Sl",
+		"FAST",
+	})
+
+	formats := []string{"json", "yaml"}
+	colors := []string{"red", "blue"}
+	modes := []string{"fast", "slow"}
+	match := szargs.OptionIgnoreCase | szargs.OptionPrefix
+
+	chk.StrSlice(
+		args.ValuesOptionMatch("[-f fmt ...]", formats, match, "formats"),
+		[]string{"json", "yaml"},
+	)
+	chk.StrSlice(
+		args.ValueOptionsMatch(
+			"[--swap from to]", 2, colors, match, "the colors",
+		),
+		[]string{"red", "blue"},
+	)
+
+	when, found := args.ValueOptionalOptionMatch(
+		"[--color[=when]]",
+		"auto",
+		[]string{"auto", "always", "never"},
+		match,
+		"the color",
+	)
+
+	chk.True(found)
+	chk.Str(when, "always")
+	chk.StrSlice(
+		args.SettingValuesOptionMatch(
+			"[--mode mode ...]", "", nil, modes, match, "the modes",
+		),
+		[]string{"fast"},
+	)
+	chk.Str(args.LastOptionMatch("last", modes, match, "the last"), "fast")
+	chk.Str(
+		args.NextOptionOrMatch("next", modes, match, "fast", "the next"),
+		"slow",
+	)
+	chk.StrSlice(
+		args.RestOptionMatch("rest", 0, -1, modes, match, "the rest"),
+		[]string{"fast", "slow"},
+	)
+	chk.Str(
+		args.NextOptionOrMatch("next", modes, match, "fast", "the next"),
+		"fast",
+	)
+
+	args.Done()
+
+	chk.NoErr(args.Err())
+}
+
+func TestSzargs_OptionMatching_Exact(t *testing.T) {
+	chk := sztestlog.CaptureNothing(t)
+	defer chk.Release()
+
+	args := szargs.New("program description", []string{
+		"programName",
+		"--format", "yml",
+	})
+
+	format, found := args.ValueOption(
+		"[--format fmt]", []string{"json", "yaml|yml"}, "the format",
+	)
+
+	chk.False(found)
+	chk.Str(format, "")
+	chk.Err(
+		args.Err(),
+		chk.ErrChain(
+			szargs.ErrInvalidOption,
+			"'yml' ([--format fmt] must be one of [json yaml|yml])",
+		),
+	)
+}
@@ -0,0 +1,89 @@
+/*
+   Szerszam argument library: szargs.
+   Copyright (C) 2024-2025  Leslie Dancsecs
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU General Public License for more details.
+
+   You should have received a copy of the GNU General Public License
+   along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package szargs
+
+import (
+	"fmt"
+	"slices"
+)
+
+// origin identifies the argument, as originally provided, that an argument
+// in the list was derived from.  The flags split from a short option group
+// share the origin of the group.
+type origin struct {
+	id   int  // Unique among the arguments of an Args.
+	argv int  // Position in the list passed to New or -1 if not there.
+	env  bool // Provided by the environment variable (see PrependEnvArgs).
+}
+
+// newOrigins returns n origins for arguments new to the list derived from
+// the argument with the from origin (IE: the contents of a response file).
+// New arguments are never found in the list passed to New.
+func (args *Args) newOrigins(n int, from origin) []origin {
+	origins := make([]origin, n)
+
+	for i := range origins {
+		origins[i] = from
+		origins[i].id = args.nextOrigin
+		origins[i].argv = -1
+		args.nextOrigin++
+	}
+
+	return origins
+}
+
+// noteOrigin adds the environment variable an argument was provided by to an
+// error about it.
+func (args *Args) noteOrigin(err error, from origin) error {
+	if err == nil || !from.env {
+		return err
+	}
+
+	return fmt.Errorf(
+		"%w from environment variable %s", err, args.envArgsName,
+	)
+}
+
+// selectedOrigin returns the origin of the occurrence of a flag selected by
+// the repeat policy or the zero origin if there were none.
+func selectedOrigin(origins []origin, policy RepeatPolicy) origin {
+	switch {
+	case len(origins) == 0:
+		return origin{id: 0, argv: -1, env: false}
+	case policy == RepeatFirstWins:
+		return origins[0]
+	default:
+		return origins[len(origins)-1]
+	}
+}
+
+// setArgs replaces the argument list along with the origins of its
+// arguments.
+func (args *Args) setArgs(list []string, origins []origin) {
+	args.args = list
+	args.origins = origins
+}
+
+// dropArg removes the argument at the index from the argument list.
+func (args *Args) dropArg(idx int) {
+	args.setArgs(
+		slices.Delete(slices.Clone(args.args), idx, idx+1),
+		slices.Delete(slices.Clone(args.origins), idx, idx+1),
+	)
+}
@@ -69,24 +69,6 @@ func intBase(str string) (string, int) {
 	return str, base10
 }
 
-func parseOption(
-	name, str string, validOptions []string,
-) (string, error) {
-	for _, validOption := range validOptions {
-		if str == validOption {
-			return str, nil
-		}
-	}
-
-	return "", fmt.Errorf(
-		"%w: '%s' (%s must be one of %v)",
-		ErrInvalidOption,
-		str,
-		name,
-		validOptions,
-	)
-}
-
 func parseFloat64(name, str string) (float64, error) {
 	result, err := strconv.ParseFloat(str, bits64)
 	if err != nil {
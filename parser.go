@@ -0,0 +1,79 @@
+/*
+   Szerszam argument library: szargs.
+   Copyright (C) 2024-2025  Leslie Dancsecs
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU General Public License for more details.
+
+   You should have received a copy of the GNU General Public License
+   along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package szargs
+
+// Parser converts a string argument into a value of type T. The name
+// identifies the source of the string (a flag, an environment variable or a
+// positional argument) and should be included in any error returned.
+type Parser[T any] interface {
+	Parse(name, str string) (T, error)
+}
+
+// ParserFunc adapts an ordinary function to the Parser interface.
+type ParserFunc[T any] func(name, str string) (T, error)
+
+// Parse calls f(name, str).
+func (f ParserFunc[T]) Parse(name, str string) (T, error) {
+	return f(name, str)
+}
+
+// Built-in parsers for the standard Go data types. Integers accept the
+// "0b", "0o", "0x" and leading "0" base prefixes.
+var (
+	// ParseString returns the argument unchanged.
+	ParseString Parser[string] = ParserFunc[string](parseString)
+	// ParseFloat64 parses a 64 bit floating point number.
+	ParseFloat64 Parser[float64] = ParserFunc[float64](parseFloat64)
+	// ParseFloat32 parses a 32 bit floating point number.
+	ParseFloat32 Parser[float32] = ParserFunc[float32](parseFloat32)
+	// ParseInt64 parses a signed 64 bit integer.
+	ParseInt64 Parser[int64] = ParserFunc[int64](parseInt64)
+	// ParseInt32 parses a signed 32 bit integer.
+	ParseInt32 Parser[int32] = ParserFunc[int32](parseInt32)
+	// ParseInt16 parses a signed 16 bit integer.
+	ParseInt16 Parser[int16] = ParserFunc[int16](parseInt16)
+	// ParseInt8 parses a signed 8 bit integer.
+	ParseInt8 Parser[int8] = ParserFunc[int8](parseInt8)
+	// ParseInt parses a signed integer.
+	ParseInt Parser[int] = ParserFunc[int](parseInt)
+	// ParseUint64 parses an unsigned 64 bit integer.
+	ParseUint64 Parser[uint64] = ParserFunc[uint64](parseUint64)
+	// ParseUint32 parses an unsigned 32 bit integer.
+	ParseUint32 Parser[uint32] = ParserFunc[uint32](parseUint32)
+	// ParseUint16 parses an unsigned 16 bit integer.
+	ParseUint16 Parser[uint16] = ParserFunc[uint16](parseUint16)
+	// ParseUint8 parses an unsigned 8 bit integer.
+	ParseUint8 Parser[uint8] = ParserFunc[uint8](parseUint8)
+	// ParseUint parses an unsigned integer.
+	ParseUint Parser[uint] = ParserFunc[uint](parseUint)
+)
+
+func parseString(_, str string) (string, error) {
+	return str, nil
+}
+
+// OptionParser returns a parser accepting only the provided valid options
+// matched as selected by the match modes (IE: OptionIgnoreCase).  The
+// canonical option matched is returned.  The Option family of functions use
+// OptionExact and their Match variants the modes provided.
+func OptionParser(validOptions []string, match OptionMatch) Parser[string] {
+	return ParserFunc[string](func(name, str string) (string, error) {
+		return parseOption(name, str, validOptions, match)
+	})
+}
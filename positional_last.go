@@ -0,0 +1,190 @@
+/*
+   Szerszam argument library: szargs.
+   Copyright (C) 2024-2025  Leslie Dancsecs
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU General Public License for more details.
+
+   You should have received a copy of the GNU General Public License
+   along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package szargs
+
+// LastString removes and returns the last argument from the argument list.
+// Taking the last argument first supports synopses ending with a fixed
+// argument following a variable number of arguments (IE: "cp SRC... DEST").
+// If the argument stream (see SetArgStream) takes the place of the last
+// argument the stream is read to its end.
+//
+// If no arguments remain, an error is registered.
+//
+// Returns the last argument value as a string.
+func (args *Args) LastString(name, desc string) string {
+	args.RegisterUsage(name, desc)
+	result, _, err := args.lastArg(name)
+	args.PushErr(err)
+
+	return result
+}
+
+// LastFloat64 removes and returns the last argument from the argument list,
+// parsing it as a 64 bit floating point number.
+//
+// If no arguments remain, or if the value has invalid syntax or is out of
+// range for a float64, an error is registered.
+//
+// Returns the last argument value parsed as a float64.
+func (args *Args) LastFloat64(name, desc string) float64 {
+	return Last(args, ParseFloat64, name, desc)
+}
+
+// LastFloat32 removes and returns the last argument from the argument list,
+// parsing it as a 32 bit floating point number.
+//
+// If no arguments remain, or if the value has invalid syntax or is out of
+// range for a float32, an error is registered.
+//
+// Returns the last argument value parsed as a float32.
+func (args *Args) LastFloat32(name, desc string) float32 {
+	return Last(args, ParseFloat32, name, desc)
+}
+
+// LastInt64 removes and returns the last argument from the argument list,
+// parsing it as a signed 64 bit integer.
+//
+// If no arguments remain, or if the value has invalid syntax or is out of
+// range for an int64, an error is registered.
+//
+// Returns the last argument value parsed as an int64.
+func (args *Args) LastInt64(name, desc string) int64 {
+	return Last(args, ParseInt64, name, desc)
+}
+
+// LastInt32 removes and returns the last argument from the argument list,
+// parsing it as a signed 32 bit integer.
+//
+// If no arguments remain, or if the value has invalid syntax or is out of
+// range for an int32, an error is registered.
+//
+// Returns the last argument value parsed as an int32.
+func (args *Args) LastInt32(name, desc string) int32 {
+	return Last(args, ParseInt32, name, desc)
+}
+
+// LastInt16 removes and returns the last argument from the argument list,
+// parsing it as a signed 16 bit integer.
+//
+// If no arguments remain, or if the value has invalid syntax or is out of
+// range for an int16, an error is registered.
+//
+// Returns the last argument value parsed as an int16.
+func (args *Args) LastInt16(name, desc string) int16 {
+	return Last(args, ParseInt16, name, desc)
+}
+
+// LastInt8 removes and returns the last argument from the argument list,
+// parsing it as a signed 8 bit integer.
+//
+// If no arguments remain, or if the value has invalid syntax or is out of
+// range for an int8, an error is registered.
+//
+// Returns the last argument value parsed as an int8.
+func (args *Args) LastInt8(name, desc string) int8 {
+	return Last(args, ParseInt8, name, desc)
+}
+
+// LastInt removes and returns the last argument from the argument list,
+// parsing it as n signed integer.
+//
+// If no arguments remain, or if the value has invalid syntax or is out of
+// range for an int, an error is registered.
+//
+// Returns the last argument value parsed as an int.
+func (args *Args) LastInt(name, desc string) int {
+	return Last(args, ParseInt, name, desc)
+}
+
+// LastUint64 removes and returns the last argument from the argument list,
+// parsing it as an unsigned 64 bit integer.
+//
+// If no arguments remain, or if the value has invalid syntax or is out of
+// range for a uint64, an error is registered.
+//
+// Returns the last argument value parsed as a uint64.
+func (args *Args) LastUint64(name, desc string) uint64 {
+	return Last(args, ParseUint64, name, desc)
+}
+
+// LastUint32 removes and returns the last argument from the argument list,
+// parsing it as an unsigned 32 bit integer.
+//
+// If no arguments remain, or if the value has invalid syntax or is out of
+// range for a uint32, an error is registered.
+//
+// Returns the last argument value parsed as a uint32.
+func (args *Args) LastUint32(name, desc string) uint32 {
+	return Last(args, ParseUint32, name, desc)
+}
+
+// LastUint16 removes and returns the last argument from the argument list,
+// parsing it as an unsigned 16 bit integer.
+//
+// If no arguments remain, or if the value has invalid syntax or is out of
+// range for a uint16, an error is registered.
+//
+// Returns the last argument value parsed as a uint16.
+func (args *Args) LastUint16(name, desc string) uint16 {
+	return Last(args, ParseUint16, name, desc)
+}
+
+// LastUint8 removes and returns the last argument from the argument list,
+// parsing it as an unsigned 8 bit integer.
+//
+// If no arguments remain, or if the value has invalid syntax or is out of
+// range for a uint8, an error is registered.
+//
+// Returns the last argument value parsed as a uint8.
+func (args *Args) LastUint8(name, desc string) uint8 {
+	return Last(args, ParseUint8, name, desc)
+}
+
+// LastUint removes and returns the last argument from the argument list,
+// parsing it as an unsigned integer.
+//
+// If no arguments remain, or if the value has invalid syntax or is out of
+// range for a uint, an error is registered.
+//
+// Returns the last argument value parsed as a uint.
+func (args *Args) LastUint(name, desc string) uint {
+	return Last(args, ParseUint, name, desc)
+}
+
+// LastOption removes and returns the last argument from the argument list.
+// The value must match one of the entries in validOptions.
+//
+// If no arguments remain, or if the value is not found in validOptions,
+// an error is registered.
+//
+// Returns the last argument value.
+func (args *Args) LastOption(
+	name string, validOptions []string, desc string,
+) string {
+	return args.LastOptionMatch(name, validOptions, OptionExact, desc)
+}
+
+// LastOptionMatch is LastOption matching the validOptions as selected by the
+// match modes (IE: OptionIgnoreCase | OptionPrefix).  The canonical option
+// matched is returned.
+func (args *Args) LastOptionMatch(
+	name string, validOptions []string, match OptionMatch, desc string,
+) string {
+	return Last(args, OptionParser(validOptions, match), name, desc)
+}
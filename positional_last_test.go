@@ -0,0 +1,397 @@
+/*
+   Szerszam argument library: szargs.
+   Copyright (C) 2024-2025  Leslie Dancsecs
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU General Public License for more details.
+
+   You should have received a copy of the GNU General Public License
+   along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package szargs_test
+
+import (
+	"testing"
+
+	"github.com/dancsecs/szargs"
+	"github.com/dancsecs/sztestlog"
+)
+
+func TestSzargs_LastString_Missing(t *testing.T) {
+	chk := sztestlog.CaptureNothing(t)
+	defer chk.Release()
+
+	args := szargs.New("program description", []string{
+		"programName",
+		"--",
+	})
+
+	result := args.LastString("DEST", "the destination")
+
+	chk.Err(
+		args.Err(),
+		chk.ErrChain(szargs.ErrMissing, "DEST"),
+	)
+	chk.Str(result, "")
+	chk.StrSlice(args.Args(), []string{"--"})
+}
+
+func TestSzargs_LastString_Success(t *testing.T) {
+	chk := sztestlog.CaptureNothing(t)
+	defer chk.Release()
+
+	args := szargs.New("program description", []string{
+		"programName",
+		"-v",
+		"src1",
+		"src2",
+		"dest",
+		"--",
+	})
+
+	chk.True(args.Is("[-v]", "verbose"))
+
+	chk.Str(args.LastString("DEST", "the destination"), "dest")
+	chk.Str(args.LastString("SRC", "the last source"), "src2")
+
+	chk.NoErr(args.Err())
+	chk.StrSlice(args.Args(), []string{"src1", "--"})
+}
+
+func TestSzargs_LastInt(t *testing.T) {
+	chk := sztestlog.CaptureNothing(t)
+	defer chk.Release()
+
+	args := szargs.New("program description", []string{
+		"programName",
+		"first",
+		"-5",
+	})
+
+	chk.Int(args.LastInt("COUNT", "the count"), -5)
+	chk.NoErr(args.Err())
+
+	chk.Int(args.LastInt("COUNT", "the count"), 0)
+	chk.Err(
+		args.Err(),
+		chk.ErrChain(
+			szargs.ErrInvalidInt,
+			szargs.ErrSyntax,
+			"COUNT",
+			"'first'",
+		),
+	)
+	chk.StrSlice(args.Args(), nil)
+}
+
+func TestSzargs_LastOption(t *testing.T) {
+	chk := sztestlog.CaptureNothing(t)
+	defer chk.Release()
+
+	args := szargs.New("program description", []string{
+		"programName",
+		"file",
+		"add",
+	})
+
+	chk.Str(
+		args.LastOption("ACTION", []string{"add", "del"}, "the action"),
+		"add",
+	)
+	chk.NoErr(args.Err())
+	chk.StrSlice(args.Args(), []string{"file"})
+}
+
+func TestSzargs_LastFloat64(t *testing.T) {
+	chk := sztestlog.CaptureNothing(t)
+	defer chk.Release()
+
+	args := szargs.New("program description", []string{
+		"programName",
+		"first",
+		"5",
+	})
+
+	chk.Float64(args.LastFloat64("COUNT", "the count"), 5, 0)
+	chk.NoErr(args.Err())
+
+	chk.Float64(args.LastFloat64("COUNT", "the count"), 0, 0)
+	chk.Err(
+		args.Err(),
+		chk.ErrChain(
+			szargs.ErrInvalidFloat64,
+			szargs.ErrSyntax,
+			"COUNT",
+			"'first'",
+		),
+	)
+	chk.StrSlice(args.Args(), nil)
+}
+
+func TestSzargs_LastFloat32(t *testing.T) {
+	chk := sztestlog.CaptureNothing(t)
+	defer chk.Release()
+
+	args := szargs.New("program description", []string{
+		"programName",
+		"first",
+		"5",
+	})
+
+	chk.Float32(args.LastFloat32("COUNT", "the count"), 5, 0)
+	chk.NoErr(args.Err())
+
+	chk.Float32(args.LastFloat32("COUNT", "the count"), 0, 0)
+	chk.Err(
+		args.Err(),
+		chk.ErrChain(
+			szargs.ErrInvalidFloat32,
+			szargs.ErrSyntax,
+			"COUNT",
+			"'first'",
+		),
+	)
+	chk.StrSlice(args.Args(), nil)
+}
+
+func TestSzargs_LastInt64(t *testing.T) {
+	chk := sztestlog.CaptureNothing(t)
+	defer chk.Release()
+
+	args := szargs.New("program description", []string{
+		"programName",
+		"first",
+		"5",
+	})
+
+	chk.Int64(args.LastInt64("COUNT", "the count"), 5)
+	chk.NoErr(args.Err())
+
+	chk.Int64(args.LastInt64("COUNT", "the count"), 0)
+	chk.Err(
+		args.Err(),
+		chk.ErrChain(
+			szargs.ErrInvalidInt64,
+			szargs.ErrSyntax,
+			"COUNT",
+			"'first'",
+		),
+	)
+	chk.StrSlice(args.Args(), nil)
+}
+
+func TestSzargs_LastInt32(t *testing.T) {
+	chk := sztestlog.CaptureNothing(t)
+	defer chk.Release()
+
+	args := szargs.New("program description", []string{
+		"programName",
+		"first",
+		"5",
+	})
+
+	chk.Int32(args.LastInt32("COUNT", "the count"), 5)
+	chk.NoErr(args.Err())
+
+	chk.Int32(args.LastInt32("COUNT", "the count"), 0)
+	chk.Err(
+		args.Err(),
+		chk.ErrChain(
+			szargs.ErrInvalidInt32,
+			szargs.ErrSyntax,
+			"COUNT",
+			"'first'",
+		),
+	)
+	chk.StrSlice(args.Args(), nil)
+}
+
+func TestSzargs_LastInt16(t *testing.T) {
+	chk := sztestlog.CaptureNothing(t)
+	defer chk.Release()
+
+	args := szargs.New("program description", []string{
+		"programName",
+		"first",
+		"5",
+	})
+
+	chk.Int16(args.LastInt16("COUNT", "the count"), 5)
+	chk.NoErr(args.Err())
+
+	chk.Int16(args.LastInt16("COUNT", "the count"), 0)
+	chk.Err(
+		args.Err(),
+		chk.ErrChain(
+			szargs.ErrInvalidInt16,
+			szargs.ErrSyntax,
+			"COUNT",
+			"'first'",
+		),
+	)
+	chk.StrSlice(args.Args(), nil)
+}
+
+func TestSzargs_LastInt8(t *testing.T) {
+	chk := sztestlog.CaptureNothing(t)
+	defer chk.Release()
+
+	args := szargs.New("program description", []string{
+		"programName",
+		"first",
+		"5",
+	})
+
+	chk.Int8(args.LastInt8("COUNT", "the count"), 5)
+	chk.NoErr(args.Err())
+
+	chk.Int8(args.LastInt8("COUNT", "the count"), 0)
+	chk.Err(
+		args.Err(),
+		chk.ErrChain(
+			szargs.ErrInvalidInt8,
+			szargs.ErrSyntax,
+			"COUNT",
+			"'first'",
+		),
+	)
+	chk.StrSlice(args.Args(), nil)
+}
+
+func TestSzargs_LastUint64(t *testing.T) {
+	chk := sztestlog.CaptureNothing(t)
+	defer chk.Release()
+
+	args := szargs.New("program description", []string{
+		"programName",
+		"first",
+		"5",
+	})
+
+	chk.Uint64(args.LastUint64("COUNT", "the count"), 5)
+	chk.NoErr(args.Err())
+
+	chk.Uint64(args.LastUint64("COUNT", "the count"), 0)
+	chk.Err(
+		args.Err(),
+		chk.ErrChain(
+			szargs.ErrInvalidUint64,
+			szargs.ErrSyntax,
+			"COUNT",
+			"'first'",
+		),
+	)
+	chk.StrSlice(args.Args(), nil)
+}
+
+func TestSzargs_LastUint32(t *testing.T) {
+	chk := sztestlog.CaptureNothing(t)
+	defer chk.Release()
+
+	args := szargs.New("program description", []string{
+		"programName",
+		"first",
+		"5",
+	})
+
+	chk.Uint32(args.LastUint32("COUNT", "the count"), 5)
+	chk.NoErr(args.Err())
+
+	chk.Uint32(args.LastUint32("COUNT", "the count"), 0)
+	chk.Err(
+		args.Err(),
+		chk.ErrChain(
+			szargs.ErrInvalidUint32,
+			szargs.ErrSyntax,
+			"COUNT",
+			"'first'",
+		),
+	)
+	chk.StrSlice(args.Args(), nil)
+}
+
+func TestSzargs_LastUint16(t *testing.T) {
+	chk := sztestlog.CaptureNothing(t)
+	defer chk.Release()
+
+	args := szargs.New("program description", []string{
+		"programName",
+		"first",
+		"5",
+	})
+
+	chk.Uint16(args.LastUint16("COUNT", "the count"), 5)
+	chk.NoErr(args.Err())
+
+	chk.Uint16(args.LastUint16("COUNT", "the count"), 0)
+	chk.Err(
+		args.Err(),
+		chk.ErrChain(
+			szargs.ErrInvalidUint16,
+			szargs.ErrSyntax,
+			"COUNT",
+			"'first'",
+		),
+	)
+	chk.StrSlice(args.Args(), nil)
+}
+
+func TestSzargs_LastUint8(t *testing.T) {
+	chk := sztestlog.CaptureNothing(t)
+	defer chk.Release()
+
+	args := szargs.New("program description", []string{
+		"programName",
+		"first",
+		"5",
+	})
+
+	chk.Uint8(args.LastUint8("COUNT", "the count"), 5)
+	chk.NoErr(args.Err())
+
+	chk.Uint8(args.LastUint8("COUNT", "the count"), 0)
+	chk.Err(
+		args.Err(),
+		chk.ErrChain(
+			szargs.ErrInvalidUint8,
+			szargs.ErrSyntax,
+			"COUNT",
+			"'first'",
+		),
+	)
+	chk.StrSlice(args.Args(), nil)
+}
+
+func TestSzargs_LastUint(t *testing.T) {
+	chk := sztestlog.CaptureNothing(t)
+	defer chk.Release()
+
+	args := szargs.New("program description", []string{
+		"programName",
+		"first",
+		"5",
+	})
+
+	chk.Uint(args.LastUint("COUNT", "the count"), 5)
+	chk.NoErr(args.Err())
+
+	chk.Uint(args.LastUint("COUNT", "the count"), 0)
+	chk.Err(
+		args.Err(),
+		chk.ErrChain(
+			szargs.ErrInvalidUint,
+			szargs.ErrSyntax,
+			"COUNT",
+			"'first'",
+		),
+	)
+	chk.StrSlice(args.Args(), nil)
+}
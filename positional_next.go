@@ -40,8 +40,7 @@ func next(name string, args []string) (string, []string, error) {
 // Returns the next argument value as a string.
 func (args *Args) NextString(name, desc string) string {
 	args.RegisterUsage(name, desc)
-	result, newArgs, err := next(name, args.args)
-	args.args = newArgs
+	result, _, err := args.nextArg(name)
 	args.PushErr(err)
 
 	return result
@@ -55,24 +54,7 @@ func (args *Args) NextString(name, desc string) string {
 //
 // Returns the next argument value parsed as a float64.
 func (args *Args) NextFloat64(name, desc string) float64 {
-	var (
-		arg    string
-		result float64
-		err    error
-	)
-
-	args.RegisterUsage(name, desc)
-
-	arg, newArgs, err := next(name, args.args)
-
-	if err == nil {
-		result, err = parseFloat64(name, arg)
-	}
-
-	args.args = newArgs
-	args.PushErr(err)
-
-	return result
+	return Next(args, ParseFloat64, name, desc)
 }
 
 // NextFloat32 removes and returns the next argument from the argument list,
@@ -83,24 +65,7 @@ func (args *Args) NextFloat64(name, desc string) float64 {
 //
 // Returns the next argument value parsed as a float32.
 func (args *Args) NextFloat32(name, desc string) float32 {
-	var (
-		arg    string
-		result float32
-		err    error
-	)
-
-	args.RegisterUsage(name, desc)
-
-	arg, newArgs, err := next(name, args.args)
-
-	if err == nil {
-		result, err = parseFloat32(name, arg)
-	}
-
-	args.args = newArgs
-	args.PushErr(err)
-
-	return result
+	return Next(args, ParseFloat32, name, desc)
 }
 
 // NextInt64 removes and returns the next argument from the argument list,
@@ -111,24 +76,7 @@ func (args *Args) NextFloat32(name, desc string) float32 {
 //
 // Returns the next argument value parsed as an int64.
 func (args *Args) NextInt64(name, desc string) int64 {
-	var (
-		arg    string
-		result int64
-		err    error
-	)
-
-	args.RegisterUsage(name, desc)
-
-	arg, newArgs, err := next(name, args.args)
-
-	if err == nil {
-		result, err = parseInt64(name, arg)
-	}
-
-	args.args = newArgs
-	args.PushErr(err)
-
-	return result
+	return Next(args, ParseInt64, name, desc)
 }
 
 // NextInt32 removes and returns the next argument from the argument list,
@@ -139,24 +87,7 @@ func (args *Args) NextInt64(name, desc string) int64 {
 //
 // Returns the next argument value parsed as an int32.
 func (args *Args) NextInt32(name, desc string) int32 {
-	var (
-		arg    string
-		result int32
-		err    error
-	)
-
-	args.RegisterUsage(name, desc)
-
-	arg, newArgs, err := next(name, args.args)
-
-	if err == nil {
-		result, err = parseInt32(name, arg)
-	}
-
-	args.args = newArgs
-	args.PushErr(err)
-
-	return result
+	return Next(args, ParseInt32, name, desc)
 }
 
 // NextInt16 removes and returns the next argument from the argument list,
@@ -167,24 +98,7 @@ func (args *Args) NextInt32(name, desc string) int32 {
 //
 // Returns the next argument value parsed as an int16.
 func (args *Args) NextInt16(name, desc string) int16 {
-	var (
-		arg    string
-		result int16
-		err    error
-	)
-
-	args.RegisterUsage(name, desc)
-
-	arg, newArgs, err := next(name, args.args)
-
-	if err == nil {
-		result, err = parseInt16(name, arg)
-	}
-
-	args.args = newArgs
-	args.PushErr(err)
-
-	return result
+	return Next(args, ParseInt16, name, desc)
 }
 
 // NextInt8 removes and returns the next argument from the argument list,
@@ -195,24 +109,7 @@ func (args *Args) NextInt16(name, desc string) int16 {
 //
 // Returns the next argument value parsed as an int8.
 func (args *Args) NextInt8(name, desc string) int8 {
-	var (
-		arg    string
-		result int8
-		err    error
-	)
-
-	args.RegisterUsage(name, desc)
-
-	arg, newArgs, err := next(name, args.args)
-
-	if err == nil {
-		result, err = parseInt8(name, arg)
-	}
-
-	args.args = newArgs
-	args.PushErr(err)
-
-	return result
+	return Next(args, ParseInt8, name, desc)
 }
 
 // NextInt removes and returns the next argument from the argument list,
@@ -223,24 +120,7 @@ func (args *Args) NextInt8(name, desc string) int8 {
 //
 // Returns the next argument value parsed as an int.
 func (args *Args) NextInt(name, desc string) int {
-	var (
-		arg    string
-		result int
-		err    error
-	)
-
-	args.RegisterUsage(name, desc)
-
-	arg, newArgs, err := next(name, args.args)
-
-	if err == nil {
-		result, err = parseInt(name, arg)
-	}
-
-	args.args = newArgs
-	args.PushErr(err)
-
-	return result
+	return Next(args, ParseInt, name, desc)
 }
 
 // NextUint64 removes and returns the next argument from the argument list,
@@ -251,24 +131,7 @@ func (args *Args) NextInt(name, desc string) int {
 //
 // Returns the next argument value parsed as a uint64.
 func (args *Args) NextUint64(name, desc string) uint64 {
-	var (
-		arg    string
-		result uint64
-		err    error
-	)
-
-	args.RegisterUsage(name, desc)
-
-	arg, newArgs, err := next(name, args.args)
-
-	if err == nil {
-		result, err = parseUint64(name, arg)
-	}
-
-	args.args = newArgs
-	args.PushErr(err)
-
-	return result
+	return Next(args, ParseUint64, name, desc)
 }
 
 // NextUint32 removes and returns the next argument from the argument list,
@@ -279,24 +142,7 @@ func (args *Args) NextUint64(name, desc string) uint64 {
 //
 // Returns the next argument value parsed as a uint32.
 func (args *Args) NextUint32(name, desc string) uint32 {
-	var (
-		arg    string
-		result uint32
-		err    error
-	)
-
-	args.RegisterUsage(name, desc)
-
-	arg, newArgs, err := next(name, args.args)
-
-	if err == nil {
-		result, err = parseUint32(name, arg)
-	}
-
-	args.args = newArgs
-	args.PushErr(err)
-
-	return result
+	return Next(args, ParseUint32, name, desc)
 }
 
 // NextUint16 removes and returns the next argument from the argument list,
@@ -307,24 +153,7 @@ func (args *Args) NextUint32(name, desc string) uint32 {
 //
 // Returns the next argument value parsed as a uint16.
 func (args *Args) NextUint16(name, desc string) uint16 {
-	var (
-		arg    string
-		result uint16
-		err    error
-	)
-
-	args.RegisterUsage(name, desc)
-
-	arg, newArgs, err := next(name, args.args)
-
-	if err == nil {
-		result, err = parseUint16(name, arg)
-	}
-
-	args.args = newArgs
-	args.PushErr(err)
-
-	return result
+	return Next(args, ParseUint16, name, desc)
 }
 
 // NextUint8 removes and returns the next argument from the argument list,
@@ -335,24 +164,7 @@ func (args *Args) NextUint16(name, desc string) uint16 {
 //
 // Returns the next argument value parsed as a uint8.
 func (args *Args) NextUint8(name, desc string) uint8 {
-	var (
-		arg    string
-		result uint8
-		err    error
-	)
-
-	args.RegisterUsage(name, desc)
-
-	arg, newArgs, err := next(name, args.args)
-
-	if err == nil {
-		result, err = parseUint8(name, arg)
-	}
-
-	args.args = newArgs
-	args.PushErr(err)
-
-	return result
+	return Next(args, ParseUint8, name, desc)
 }
 
 // NextUint removes and returns the next argument from the argument list,
@@ -363,24 +175,7 @@ func (args *Args) NextUint8(name, desc string) uint8 {
 //
 // Returns the next argument value parsed as a uint.
 func (args *Args) NextUint(name, desc string) uint {
-	var (
-		arg    string
-		result uint
-		err    error
-	)
-
-	args.RegisterUsage(name, desc)
-
-	arg, newArgs, err := next(name, args.args)
-
-	if err == nil {
-		result, err = parseUint(name, arg)
-	}
-
-	args.args = newArgs
-	args.PushErr(err)
-
-	return result
+	return Next(args, ParseUint, name, desc)
 }
 
 // NextOption removes and returns the next argument from the argument list.
@@ -393,22 +188,14 @@ func (args *Args) NextUint(name, desc string) uint {
 func (args *Args) NextOption(
 	name string, validOptions []string, desc string,
 ) string {
-	var (
-		arg    string
-		result string
-		err    error
-	)
-
-	args.RegisterUsage(name, desc)
-
-	arg, newArgs, err := next(name, args.args)
-
-	if err == nil {
-		result, err = parseOption(name, arg, validOptions)
-	}
-
-	args.args = newArgs
-	args.PushErr(err)
+	return args.NextOptionMatch(name, validOptions, OptionExact, desc)
+}
 
-	return result
+// NextOptionMatch is NextOption matching the validOptions as selected by the
+// match modes (IE: OptionIgnoreCase | OptionPrefix).  The canonical option
+// matched is returned.
+func (args *Args) NextOptionMatch(
+	name string, validOptions []string, match OptionMatch, desc string,
+) string {
+	return Next(args, OptionParser(validOptions, match), name, desc)
 }
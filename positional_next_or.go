@@ -0,0 +1,236 @@
+/*
+   Szerszam argument library: szargs.
+   Copyright (C) 2024-2025  Leslie Dancsecs
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU General Public License for more details.
+
+   You should have received a copy of the GNU General Public License
+   along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package szargs
+
+import "strings"
+
+// registerOptional registers the usage of an optional positional argument
+// rendering its name in brackets (IE: "[name]") and its default value.
+func (args *Args) registerOptional(name, def, desc string) {
+	item := name
+	if !strings.HasPrefix(name, "[") {
+		item = "[" + name + "]"
+	}
+
+	args.RegisterUsage(item, desc+"\n\ndefault: "+def)
+}
+
+// NextStringOr removes and returns the next argument from the argument list or
+// the default if no arguments remain.
+//
+// Returns the next argument value as a string.
+func (args *Args) NextStringOr(name, def, desc string) string {
+	args.registerOptional(name, def, desc)
+
+	if !args.HasNext() {
+		return def
+	}
+
+	result, _, err := args.nextArg(name)
+	args.PushErr(err)
+
+	return result
+}
+
+// NextFloat64Or removes and returns the next argument from the argument list,
+// parsing it as a 64 bit floating point number, or the default if no arguments
+// remain.
+//
+// If the value has invalid syntax or is out of range for a float64, an error
+// is registered.
+//
+// Returns the next argument value parsed as float64 or the default.
+func (args *Args) NextFloat64Or(
+	name string, def float64, desc string,
+) float64 {
+	return NextOr(args, ParseFloat64, name, def, desc)
+}
+
+// NextFloat32Or removes and returns the next argument from the argument list,
+// parsing it as a 32 bit floating point number, or the default if no arguments
+// remain.
+//
+// If the value has invalid syntax or is out of range for a float32, an error
+// is registered.
+//
+// Returns the next argument value parsed as float32 or the default.
+func (args *Args) NextFloat32Or(
+	name string, def float32, desc string,
+) float32 {
+	return NextOr(args, ParseFloat32, name, def, desc)
+}
+
+// NextInt64Or removes and returns the next argument from the argument list,
+// parsing it as a signed 64 bit integer, or the default if no arguments
+// remain.
+//
+// If the value has invalid syntax or is out of range for an int64, an error is
+// registered.
+//
+// Returns the next argument value parsed as int64 or the default.
+func (args *Args) NextInt64Or(
+	name string, def int64, desc string,
+) int64 {
+	return NextOr(args, ParseInt64, name, def, desc)
+}
+
+// NextInt32Or removes and returns the next argument from the argument list,
+// parsing it as a signed 32 bit integer, or the default if no arguments
+// remain.
+//
+// If the value has invalid syntax or is out of range for an int32, an error is
+// registered.
+//
+// Returns the next argument value parsed as int32 or the default.
+func (args *Args) NextInt32Or(
+	name string, def int32, desc string,
+) int32 {
+	return NextOr(args, ParseInt32, name, def, desc)
+}
+
+// NextInt16Or removes and returns the next argument from the argument list,
+// parsing it as a signed 16 bit integer, or the default if no arguments
+// remain.
+//
+// If the value has invalid syntax or is out of range for an int16, an error is
+// registered.
+//
+// Returns the next argument value parsed as int16 or the default.
+func (args *Args) NextInt16Or(
+	name string, def int16, desc string,
+) int16 {
+	return NextOr(args, ParseInt16, name, def, desc)
+}
+
+// NextInt8Or removes and returns the next argument from the argument list,
+// parsing it as a signed 8 bit integer, or the default if no arguments remain.
+//
+// If the value has invalid syntax or is out of range for an int8, an error is
+// registered.
+//
+// Returns the next argument value parsed as int8 or the default.
+func (args *Args) NextInt8Or(
+	name string, def int8, desc string,
+) int8 {
+	return NextOr(args, ParseInt8, name, def, desc)
+}
+
+// NextIntOr removes and returns the next argument from the argument list,
+// parsing it as a signed integer, or the default if no arguments remain.
+//
+// If the value has invalid syntax or is out of range for an int, an error is
+// registered.
+//
+// Returns the next argument value parsed as int or the default.
+func (args *Args) NextIntOr(
+	name string, def int, desc string,
+) int {
+	return NextOr(args, ParseInt, name, def, desc)
+}
+
+// NextUint64Or removes and returns the next argument from the argument list,
+// parsing it as an unsigned 64 bit integer, or the default if no arguments
+// remain.
+//
+// If the value has invalid syntax or is out of range for a uint64, an error is
+// registered.
+//
+// Returns the next argument value parsed as uint64 or the default.
+func (args *Args) NextUint64Or(
+	name string, def uint64, desc string,
+) uint64 {
+	return NextOr(args, ParseUint64, name, def, desc)
+}
+
+// NextUint32Or removes and returns the next argument from the argument list,
+// parsing it as an unsigned 32 bit integer, or the default if no arguments
+// remain.
+//
+// If the value has invalid syntax or is out of range for a uint32, an error is
+// registered.
+//
+// Returns the next argument value parsed as uint32 or the default.
+func (args *Args) NextUint32Or(
+	name string, def uint32, desc string,
+) uint32 {
+	return NextOr(args, ParseUint32, name, def, desc)
+}
+
+// NextUint16Or removes and returns the next argument from the argument list,
+// parsing it as an unsigned 16 bit integer, or the default if no arguments
+// remain.
+//
+// If the value has invalid syntax or is out of range for a uint16, an error is
+// registered.
+//
+// Returns the next argument value parsed as uint16 or the default.
+func (args *Args) NextUint16Or(
+	name string, def uint16, desc string,
+) uint16 {
+	return NextOr(args, ParseUint16, name, def, desc)
+}
+
+// NextUint8Or removes and returns the next argument from the argument list,
+// parsing it as an unsigned 8 bit integer, or the default if no arguments
+// remain.
+//
+// If the value has invalid syntax or is out of range for a uint8, an error is
+// registered.
+//
+// Returns the next argument value parsed as uint8 or the default.
+func (args *Args) NextUint8Or(
+	name string, def uint8, desc string,
+) uint8 {
+	return NextOr(args, ParseUint8, name, def, desc)
+}
+
+// NextUintOr removes and returns the next argument from the argument list,
+// parsing it as an unsigned integer, or the default if no arguments remain.
+//
+// If the value has invalid syntax or is out of range for a uint, an error is
+// registered.
+//
+// Returns the next argument value parsed as uint or the default.
+func (args *Args) NextUintOr(
+	name string, def uint, desc string,
+) uint {
+	return NextOr(args, ParseUint, name, def, desc)
+}
+
+// NextOptionOr removes and returns the next argument from the argument list or
+// the default if no arguments remain. The value must match one of the entries
+// in validOptions.
+//
+// If the value is not found in validOptions, an error is registered.
+//
+// Returns the next argument value or the default.
+func (args *Args) NextOptionOr(
+	name string, validOptions []string, def, desc string,
+) string {
+	return args.NextOptionOrMatch(name, validOptions, OptionExact, def, desc)
+}
+
+// NextOptionOrMatch is NextOptionOr matching the validOptions as selected by
+// the match modes (IE: OptionIgnoreCase | OptionPrefix).  The canonical option
+// matched is returned.
+func (args *Args) NextOptionOrMatch(
+	name string, validOptions []string, match OptionMatch, def, desc string,
+) string {
+	return NextOr(args, OptionParser(validOptions, match), name, def, desc)
+}
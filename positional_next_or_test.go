@@ -0,0 +1,459 @@
+/*
+   Szerszam argument library: szargs.
+   Copyright (C) 2024-2025  Leslie Dancsecs
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU General Public License for more details.
+
+   You should have received a copy of the GNU General Public License
+   along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package szargs_test
+
+import (
+	"testing"
+
+	"github.com/dancsecs/szargs"
+	"github.com/dancsecs/sztestlog"
+)
+
+func TestSzargs_NextStringOr(t *testing.T) {
+	chk := sztestlog.CaptureNothing(t)
+	defer chk.Release()
+
+	args := szargs.New("program description", []string{
+		"programName",
+		"given",
+	})
+
+	chk.Str(args.NextStringOr("first", "def1", "the first"), "given")
+	chk.Str(args.NextStringOr("[second]", "def2", "the second"), "def2")
+
+	args.Done()
+
+	chk.NoErr(args.Err())
+	chk.Str(
+		args.Usage(0),
+		"usage: programName [first] [second]\n"+
+			"\n"+
+			"program description\n"+
+			"\n"+
+			"    [first]\n"+
+			"        the first\n"+
+			"\n"+
+			"        default: def1\n"+
+			"\n"+
+			"    [second]\n"+
+			"        the second\n"+
+			"\n"+
+			"        default: def2",
+	)
+}
+
+func TestSzargs_NextIntOr(t *testing.T) {
+	chk := sztestlog.CaptureNothing(t)
+	defer chk.Release()
+
+	args := szargs.New("program description", []string{
+		"programName",
+		"-3",
+		"--",
+	})
+
+	chk.Int(args.NextIntOr("count", 7, "the count"), -3)
+	chk.Int(args.NextIntOr("count", 7, "the count"), 7)
+	chk.NoErr(args.Err())
+
+	args = szargs.New("program description", []string{
+		"programName",
+		"three",
+	})
+
+	chk.Int(args.NextIntOr("count", 7, "the count"), 0)
+	chk.Err(
+		args.Err(),
+		chk.ErrChain(
+			szargs.ErrInvalidInt,
+			szargs.ErrSyntax,
+			"count",
+			"'three'",
+		),
+	)
+}
+
+func TestSzargs_NextOptionOr(t *testing.T) {
+	chk := sztestlog.CaptureNothing(t)
+	defer chk.Release()
+
+	options := []string{"add", "average"}
+
+	args := szargs.New("program description", []string{
+		"programName",
+	})
+
+	chk.Str(args.NextOptionOr("operation", options, "add", "the op"), "add")
+	chk.NoErr(args.Err())
+
+	args = szargs.New("program description", []string{
+		"programName",
+		"sum",
+	})
+
+	chk.Str(args.NextOptionOr("operation", options, "add", "the op"), "")
+	chk.Err(
+		args.Err(),
+		chk.ErrChain(
+			szargs.ErrInvalidOption,
+			"'sum' (operation must be one of [add average])",
+		),
+	)
+}
+
+func TestSzargs_NextFloat64Or(t *testing.T) {
+	chk := sztestlog.CaptureNothing(t)
+	defer chk.Release()
+
+	args := szargs.New("program description", []string{
+		"programName",
+		"3",
+		"--",
+	})
+
+	chk.Float64(args.NextFloat64Or("count", 7, "the count"), 3, 0)
+	chk.Float64(args.NextFloat64Or("count", 7, "the count"), 7, 0)
+	chk.NoErr(args.Err())
+
+	args = szargs.New("program description", []string{
+		"programName",
+		"three",
+	})
+
+	chk.Float64(args.NextFloat64Or("count", 7, "the count"), 0, 0)
+	chk.Err(
+		args.Err(),
+		chk.ErrChain(
+			szargs.ErrInvalidFloat64,
+			szargs.ErrSyntax,
+			"count",
+			"'three'",
+		),
+	)
+}
+
+func TestSzargs_NextFloat32Or(t *testing.T) {
+	chk := sztestlog.CaptureNothing(t)
+	defer chk.Release()
+
+	args := szargs.New("program description", []string{
+		"programName",
+		"3",
+		"--",
+	})
+
+	chk.Float32(args.NextFloat32Or("count", 7, "the count"), 3, 0)
+	chk.Float32(args.NextFloat32Or("count", 7, "the count"), 7, 0)
+	chk.NoErr(args.Err())
+
+	args = szargs.New("program description", []string{
+		"programName",
+		"three",
+	})
+
+	chk.Float32(args.NextFloat32Or("count", 7, "the count"), 0, 0)
+	chk.Err(
+		args.Err(),
+		chk.ErrChain(
+			szargs.ErrInvalidFloat32,
+			szargs.ErrSyntax,
+			"count",
+			"'three'",
+		),
+	)
+}
+
+func TestSzargs_NextInt64Or(t *testing.T) {
+	chk := sztestlog.CaptureNothing(t)
+	defer chk.Release()
+
+	args := szargs.New("program description", []string{
+		"programName",
+		"3",
+		"--",
+	})
+
+	chk.Int64(args.NextInt64Or("count", 7, "the count"), 3)
+	chk.Int64(args.NextInt64Or("count", 7, "the count"), 7)
+	chk.NoErr(args.Err())
+
+	args = szargs.New("program description", []string{
+		"programName",
+		"three",
+	})
+
+	chk.Int64(args.NextInt64Or("count", 7, "the count"), 0)
+	chk.Err(
+		args.Err(),
+		chk.ErrChain(
+			szargs.ErrInvalidInt64,
+			szargs.ErrSyntax,
+			"count",
+			"'three'",
+		),
+	)
+}
+
+func TestSzargs_NextInt32Or(t *testing.T) {
+	chk := sztestlog.CaptureNothing(t)
+	defer chk.Release()
+
+	args := szargs.New("program description", []string{
+		"programName",
+		"3",
+		"--",
+	})
+
+	chk.Int32(args.NextInt32Or("count", 7, "the count"), 3)
+	chk.Int32(args.NextInt32Or("count", 7, "the count"), 7)
+	chk.NoErr(args.Err())
+
+	args = szargs.New("program description", []string{
+		"programName",
+		"three",
+	})
+
+	chk.Int32(args.NextInt32Or("count", 7, "the count"), 0)
+	chk.Err(
+		args.Err(),
+		chk.ErrChain(
+			szargs.ErrInvalidInt32,
+			szargs.ErrSyntax,
+			"count",
+			"'three'",
+		),
+	)
+}
+
+func TestSzargs_NextInt16Or(t *testing.T) {
+	chk := sztestlog.CaptureNothing(t)
+	defer chk.Release()
+
+	args := szargs.New("program description", []string{
+		"programName",
+		"3",
+		"--",
+	})
+
+	chk.Int16(args.NextInt16Or("count", 7, "the count"), 3)
+	chk.Int16(args.NextInt16Or("count", 7, "the count"), 7)
+	chk.NoErr(args.Err())
+
+	args = szargs.New("program description", []string{
+		"programName",
+		"three",
+	})
+
+	chk.Int16(args.NextInt16Or("count", 7, "the count"), 0)
+	chk.Err(
+		args.Err(),
+		chk.ErrChain(
+			szargs.ErrInvalidInt16,
+			szargs.ErrSyntax,
+			"count",
+			"'three'",
+		),
+	)
+}
+
+func TestSzargs_NextInt8Or(t *testing.T) {
+	chk := sztestlog.CaptureNothing(t)
+	defer chk.Release()
+
+	args := szargs.New("program description", []string{
+		"programName",
+		"3",
+		"--",
+	})
+
+	chk.Int8(args.NextInt8Or("count", 7, "the count"), 3)
+	chk.Int8(args.NextInt8Or("count", 7, "the count"), 7)
+	chk.NoErr(args.Err())
+
+	args = szargs.New("program description", []string{
+		"programName",
+		"three",
+	})
+
+	chk.Int8(args.NextInt8Or("count", 7, "the count"), 0)
+	chk.Err(
+		args.Err(),
+		chk.ErrChain(
+			szargs.ErrInvalidInt8,
+			szargs.ErrSyntax,
+			"count",
+			"'three'",
+		),
+	)
+}
+
+func TestSzargs_NextUint64Or(t *testing.T) {
+	chk := sztestlog.CaptureNothing(t)
+	defer chk.Release()
+
+	args := szargs.New("program description", []string{
+		"programName",
+		"3",
+		"--",
+	})
+
+	chk.Uint64(args.NextUint64Or("count", 7, "the count"), 3)
+	chk.Uint64(args.NextUint64Or("count", 7, "the count"), 7)
+	chk.NoErr(args.Err())
+
+	args = szargs.New("program description", []string{
+		"programName",
+		"three",
+	})
+
+	chk.Uint64(args.NextUint64Or("count", 7, "the count"), 0)
+	chk.Err(
+		args.Err(),
+		chk.ErrChain(
+			szargs.ErrInvalidUint64,
+			szargs.ErrSyntax,
+			"count",
+			"'three'",
+		),
+	)
+}
+
+func TestSzargs_NextUint32Or(t *testing.T) {
+	chk := sztestlog.CaptureNothing(t)
+	defer chk.Release()
+
+	args := szargs.New("program description", []string{
+		"programName",
+		"3",
+		"--",
+	})
+
+	chk.Uint32(args.NextUint32Or("count", 7, "the count"), 3)
+	chk.Uint32(args.NextUint32Or("count", 7, "the count"), 7)
+	chk.NoErr(args.Err())
+
+	args = szargs.New("program description", []string{
+		"programName",
+		"three",
+	})
+
+	chk.Uint32(args.NextUint32Or("count", 7, "the count"), 0)
+	chk.Err(
+		args.Err(),
+		chk.ErrChain(
+			szargs.ErrInvalidUint32,
+			szargs.ErrSyntax,
+			"count",
+			"'three'",
+		),
+	)
+}
+
+func TestSzargs_NextUint16Or(t *testing.T) {
+	chk := sztestlog.CaptureNothing(t)
+	defer chk.Release()
+
+	args := szargs.New("program description", []string{
+		"programName",
+		"3",
+		"--",
+	})
+
+	chk.Uint16(args.NextUint16Or("count", 7, "the count"), 3)
+	chk.Uint16(args.NextUint16Or("count", 7, "the count"), 7)
+	chk.NoErr(args.Err())
+
+	args = szargs.New("program description", []string{
+		"programName",
+		"three",
+	})
+
+	chk.Uint16(args.NextUint16Or("count", 7, "the count"), 0)
+	chk.Err(
+		args.Err(),
+		chk.ErrChain(
+			szargs.ErrInvalidUint16,
+			szargs.ErrSyntax,
+			"count",
+			"'three'",
+		),
+	)
+}
+
+func TestSzargs_NextUint8Or(t *testing.T) {
+	chk := sztestlog.CaptureNothing(t)
+	defer chk.Release()
+
+	args := szargs.New("program description", []string{
+		"programName",
+		"3",
+		"--",
+	})
+
+	chk.Uint8(args.NextUint8Or("count", 7, "the count"), 3)
+	chk.Uint8(args.NextUint8Or("count", 7, "the count"), 7)
+	chk.NoErr(args.Err())
+
+	args = szargs.New("program description", []string{
+		"programName",
+		"three",
+	})
+
+	chk.Uint8(args.NextUint8Or("count", 7, "the count"), 0)
+	chk.Err(
+		args.Err(),
+		chk.ErrChain(
+			szargs.ErrInvalidUint8,
+			szargs.ErrSyntax,
+			"count",
+			"'three'",
+		),
+	)
+}
+
+func TestSzargs_NextUintOr(t *testing.T) {
+	chk := sztestlog.CaptureNothing(t)
+	defer chk.Release()
+
+	args := szargs.New("program description", []string{
+		"programName",
+		"3",
+		"--",
+	})
+
+	chk.Uint(args.NextUintOr("count", 7, "the count"), 3)
+	chk.Uint(args.NextUintOr("count", 7, "the count"), 7)
+	chk.NoErr(args.Err())
+
+	args = szargs.New("program description", []string{
+		"programName",
+		"three",
+	})
+
+	chk.Uint(args.NextUintOr("count", 7, "the count"), 0)
+	chk.Err(
+		args.Err(),
+		chk.ErrChain(
+			szargs.ErrInvalidUint,
+			szargs.ErrSyntax,
+			"count",
+			"'three'",
+		),
+	)
+}
@@ -0,0 +1,265 @@
+/*
+   Szerszam argument library: szargs.
+   Copyright (C) 2024-2025  Leslie Dancsecs
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU General Public License for more details.
+
+   You should have received a copy of the GNU General Public License
+   along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package szargs
+
+// RestString removes and returns all remaining arguments from the argument
+// list including any read from the argument stream (see SetArgStream). An
+// end of options marker ("--") not yet consumed is discarded.
+//
+// If fewer than minCount arguments remain an ErrMissing error is registered.
+// If more than maxCount arguments remain an ErrUnexpected error is registered.
+// A negative maxCount imposes no upper limit.
+//
+// Returns the remaining arguments as strings.
+func (args *Args) RestString(
+	name string, minCount, maxCount int, desc string,
+) []string {
+	args.RegisterUsage(name, desc)
+	result, _, err := args.restArgs(name, minCount, maxCount)
+	args.PushErr(err)
+
+	return result
+}
+
+// RestFloat64 removes and returns all remaining arguments from the argument
+// list parsing each as a 64 bit floating point number. An end of options
+// marker ("--") not yet consumed is discarded.
+//
+// If fewer than minCount arguments remain an ErrMissing error is registered.
+// If more than maxCount arguments remain an ErrUnexpected error is registered.
+// A negative maxCount imposes no upper limit. If any argument has invalid
+// syntax or is out of range for a float64, an error is registered.
+//
+// Returns the remaining arguments parsed as float64 values.
+func (args *Args) RestFloat64(
+	name string, minCount, maxCount int, desc string,
+) []float64 {
+	return Rest(args, ParseFloat64, name, minCount, maxCount, desc)
+}
+
+// RestFloat32 removes and returns all remaining arguments from the argument
+// list parsing each as a 32 bit floating point number. An end of options
+// marker ("--") not yet consumed is discarded.
+//
+// If fewer than minCount arguments remain an ErrMissing error is registered.
+// If more than maxCount arguments remain an ErrUnexpected error is registered.
+// A negative maxCount imposes no upper limit. If any argument has invalid
+// syntax or is out of range for a float32, an error is registered.
+//
+// Returns the remaining arguments parsed as float32 values.
+func (args *Args) RestFloat32(
+	name string, minCount, maxCount int, desc string,
+) []float32 {
+	return Rest(args, ParseFloat32, name, minCount, maxCount, desc)
+}
+
+// RestInt64 removes and returns all remaining arguments from the argument list
+// parsing each as a signed 64 bit integer. An end of options marker ("--") not
+// yet consumed is discarded.
+//
+// If fewer than minCount arguments remain an ErrMissing error is registered.
+// If more than maxCount arguments remain an ErrUnexpected error is registered.
+// A negative maxCount imposes no upper limit. If any argument has invalid
+// syntax or is out of range for an int64, an error is registered.
+//
+// Returns the remaining arguments parsed as int64 values.
+func (args *Args) RestInt64(
+	name string, minCount, maxCount int, desc string,
+) []int64 {
+	return Rest(args, ParseInt64, name, minCount, maxCount, desc)
+}
+
+// RestInt32 removes and returns all remaining arguments from the argument list
+// parsing each as a signed 32 bit integer. An end of options marker ("--") not
+// yet consumed is discarded.
+//
+// If fewer than minCount arguments remain an ErrMissing error is registered.
+// If more than maxCount arguments remain an ErrUnexpected error is registered.
+// A negative maxCount imposes no upper limit. If any argument has invalid
+// syntax or is out of range for an int32, an error is registered.
+//
+// Returns the remaining arguments parsed as int32 values.
+func (args *Args) RestInt32(
+	name string, minCount, maxCount int, desc string,
+) []int32 {
+	return Rest(args, ParseInt32, name, minCount, maxCount, desc)
+}
+
+// RestInt16 removes and returns all remaining arguments from the argument list
+// parsing each as a signed 16 bit integer. An end of options marker ("--") not
+// yet consumed is discarded.
+//
+// If fewer than minCount arguments remain an ErrMissing error is registered.
+// If more than maxCount arguments remain an ErrUnexpected error is registered.
+// A negative maxCount imposes no upper limit. If any argument has invalid
+// syntax or is out of range for an int16, an error is registered.
+//
+// Returns the remaining arguments parsed as int16 values.
+func (args *Args) RestInt16(
+	name string, minCount, maxCount int, desc string,
+) []int16 {
+	return Rest(args, ParseInt16, name, minCount, maxCount, desc)
+}
+
+// RestInt8 removes and returns all remaining arguments from the argument list
+// parsing each as a signed 8 bit integer. An end of options marker ("--") not
+// yet consumed is discarded.
+//
+// If fewer than minCount arguments remain an ErrMissing error is registered.
+// If more than maxCount arguments remain an ErrUnexpected error is registered.
+// A negative maxCount imposes no upper limit. If any argument has invalid
+// syntax or is out of range for an int8, an error is registered.
+//
+// Returns the remaining arguments parsed as int8 values.
+func (args *Args) RestInt8(
+	name string, minCount, maxCount int, desc string,
+) []int8 {
+	return Rest(args, ParseInt8, name, minCount, maxCount, desc)
+}
+
+// RestInt removes and returns all remaining arguments from the argument list
+// parsing each as a signed integer. An end of options marker ("--") not yet
+// consumed is discarded.
+//
+// If fewer than minCount arguments remain an ErrMissing error is registered.
+// If more than maxCount arguments remain an ErrUnexpected error is registered.
+// A negative maxCount imposes no upper limit. If any argument has invalid
+// syntax or is out of range for an int, an error is registered.
+//
+// Returns the remaining arguments parsed as int values.
+func (args *Args) RestInt(
+	name string, minCount, maxCount int, desc string,
+) []int {
+	return Rest(args, ParseInt, name, minCount, maxCount, desc)
+}
+
+// RestUint64 removes and returns all remaining arguments from the argument
+// list parsing each as an unsigned 64 bit integer. An end of options marker
+// ("--") not yet consumed is discarded.
+//
+// If fewer than minCount arguments remain an ErrMissing error is registered.
+// If more than maxCount arguments remain an ErrUnexpected error is registered.
+// A negative maxCount imposes no upper limit. If any argument has invalid
+// syntax or is out of range for a uint64, an error is registered.
+//
+// Returns the remaining arguments parsed as uint64 values.
+func (args *Args) RestUint64(
+	name string, minCount, maxCount int, desc string,
+) []uint64 {
+	return Rest(args, ParseUint64, name, minCount, maxCount, desc)
+}
+
+// RestUint32 removes and returns all remaining arguments from the argument
+// list parsing each as an unsigned 32 bit integer. An end of options marker
+// ("--") not yet consumed is discarded.
+//
+// If fewer than minCount arguments remain an ErrMissing error is registered.
+// If more than maxCount arguments remain an ErrUnexpected error is registered.
+// A negative maxCount imposes no upper limit. If any argument has invalid
+// syntax or is out of range for a uint32, an error is registered.
+//
+// Returns the remaining arguments parsed as uint32 values.
+func (args *Args) RestUint32(
+	name string, minCount, maxCount int, desc string,
+) []uint32 {
+	return Rest(args, ParseUint32, name, minCount, maxCount, desc)
+}
+
+// RestUint16 removes and returns all remaining arguments from the argument
+// list parsing each as an unsigned 16 bit integer. An end of options marker
+// ("--") not yet consumed is discarded.
+//
+// If fewer than minCount arguments remain an ErrMissing error is registered.
+// If more than maxCount arguments remain an ErrUnexpected error is registered.
+// A negative maxCount imposes no upper limit. If any argument has invalid
+// syntax or is out of range for a uint16, an error is registered.
+//
+// Returns the remaining arguments parsed as uint16 values.
+func (args *Args) RestUint16(
+	name string, minCount, maxCount int, desc string,
+) []uint16 {
+	return Rest(args, ParseUint16, name, minCount, maxCount, desc)
+}
+
+// RestUint8 removes and returns all remaining arguments from the argument list
+// parsing each as an unsigned 8 bit integer. An end of options marker ("--")
+// not yet consumed is discarded.
+//
+// If fewer than minCount arguments remain an ErrMissing error is registered.
+// If more than maxCount arguments remain an ErrUnexpected error is registered.
+// A negative maxCount imposes no upper limit. If any argument has invalid
+// syntax or is out of range for a uint8, an error is registered.
+//
+// Returns the remaining arguments parsed as uint8 values.
+func (args *Args) RestUint8(
+	name string, minCount, maxCount int, desc string,
+) []uint8 {
+	return Rest(args, ParseUint8, name, minCount, maxCount, desc)
+}
+
+// RestUint removes and returns all remaining arguments from the argument list
+// parsing each as an unsigned integer. An end of options marker ("--") not yet
+// consumed is discarded.
+//
+// If fewer than minCount arguments remain an ErrMissing error is registered.
+// If more than maxCount arguments remain an ErrUnexpected error is registered.
+// A negative maxCount imposes no upper limit. If any argument has invalid
+// syntax or is out of range for a uint, an error is registered.
+//
+// Returns the remaining arguments parsed as uint values.
+func (args *Args) RestUint(
+	name string, minCount, maxCount int, desc string,
+) []uint {
+	return Rest(args, ParseUint, name, minCount, maxCount, desc)
+}
+
+// RestOption removes and returns all remaining arguments from the argument
+// list. Each must match one of the entries in validOptions. An end of options
+// marker ("--") not yet consumed is discarded.
+//
+// If fewer than minCount arguments remain an ErrMissing error is registered.
+// If more than maxCount arguments remain an ErrUnexpected error is registered.
+// A negative maxCount imposes no upper limit. If any argument is not found in
+// validOptions, an error is registered.
+//
+// Returns the remaining arguments.
+func (args *Args) RestOption(
+	name string, minCount, maxCount int, validOptions []string, desc string,
+) []string {
+	return args.RestOptionMatch(
+		name, minCount, maxCount, validOptions, OptionExact, desc,
+	)
+}
+
+// RestOptionMatch is RestOption matching the validOptions as selected by the
+// match modes (IE: OptionIgnoreCase | OptionPrefix).  The canonical option
+// matched is returned.
+func (args *Args) RestOptionMatch(
+	name string, minCount, maxCount int, validOptions []string,
+	match OptionMatch, desc string,
+) []string {
+	return Rest(
+		args,
+		OptionParser(validOptions, match),
+		name,
+		minCount,
+		maxCount,
+		desc,
+	)
+}
@@ -0,0 +1,514 @@
+/*
+   Szerszam argument library: szargs.
+   Copyright (C) 2024-2025  Leslie Dancsecs
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU General Public License for more details.
+
+   You should have received a copy of the GNU General Public License
+   along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package szargs_test
+
+import (
+	"testing"
+
+	"github.com/dancsecs/szargs"
+	"github.com/dancsecs/sztestlog"
+)
+
+func TestSzargs_RestString(t *testing.T) {
+	chk := sztestlog.CaptureNothing(t)
+	defer chk.Release()
+
+	args := szargs.New("program description", []string{
+		"programName",
+		"src1",
+		"--",
+		"-src2",
+		"dest",
+	})
+
+	chk.Str(args.LastString("DEST", "the destination"), "dest")
+	chk.StrSlice(
+		args.RestString("SRC...", 1, -1, "the sources"),
+		[]string{"src1", "-src2"},
+	)
+
+	args.Done()
+
+	chk.NoErr(args.Err())
+	chk.False(args.HasNext())
+	chk.StrSlice(args.Args(), nil)
+}
+
+func TestSzargs_RestString_Missing(t *testing.T) {
+	chk := sztestlog.CaptureNothing(t)
+	defer chk.Release()
+
+	args := szargs.New("program description", []string{
+		"programName",
+		"src1",
+	})
+
+	chk.StrSlice(args.RestString("SRC...", 2, -1, "the sources"), nil)
+	chk.Err(
+		args.Err(),
+		chk.ErrChain(
+			szargs.ErrMissing,
+			"'SRC...' expects at least 2 values but found 1",
+		),
+	)
+	chk.StrSlice(args.Args(), nil)
+}
+
+func TestSzargs_RestString_Unexpected(t *testing.T) {
+	chk := sztestlog.CaptureNothing(t)
+	defer chk.Release()
+
+	args := szargs.New("program description", []string{
+		"programName",
+		"a", "b", "c",
+	})
+
+	chk.StrSlice(args.RestString("FILE...", 0, 2, "the files"), nil)
+	chk.Err(
+		args.Err(),
+		chk.ErrChain(
+			szargs.ErrUnexpected,
+			"'FILE...' expects at most 2 values but found 3",
+		),
+	)
+	chk.StrSlice(args.Args(), nil)
+}
+
+func TestSzargs_RestInt(t *testing.T) {
+	chk := sztestlog.CaptureNothing(t)
+	defer chk.Release()
+
+	args := szargs.New("program description", []string{
+		"programName",
+		"1", "-2", "3",
+	})
+
+	chk.IntSlice(args.RestInt("N...", 0, -1, "the numbers"), []int{1, -2, 3})
+	chk.NoErr(args.Err())
+
+	chk.IntSlice(args.RestInt("N...", 0, -1, "the numbers"), nil)
+	chk.NoErr(args.Err())
+
+	args = szargs.New("program description", []string{
+		"programName",
+		"1", "two", "3", "four",
+	})
+
+	chk.IntSlice(args.RestInt("N...", 0, -1, "the numbers"), nil)
+	chk.Err(
+		args.Err(),
+		chk.ErrChain(
+			szargs.ErrInvalidInt,
+			szargs.ErrSyntax,
+			"N...",
+			"'two'",
+			szargs.ErrInvalidInt,
+			szargs.ErrSyntax,
+			"N...",
+			"'four'",
+		),
+	)
+}
+
+func TestSzargs_RestOption(t *testing.T) {
+	chk := sztestlog.CaptureNothing(t)
+	defer chk.Release()
+
+	args := szargs.New("program description", []string{
+		"programName",
+		"add", "pink",
+	})
+
+	chk.StrSlice(
+		args.RestOption(
+			"ACTION...", 1, -1, []string{"add", "del"}, "the actions",
+		),
+		nil,
+	)
+	chk.Err(
+		args.Err(),
+		chk.ErrChain(
+			szargs.ErrInvalidOption,
+			"'pink' (ACTION... must be one of [add del])",
+		),
+	)
+}
+
+func TestSzargs_RestFloat64(t *testing.T) {
+	chk := sztestlog.CaptureNothing(t)
+	defer chk.Release()
+
+	args := szargs.New("program description", []string{
+		"programName",
+		"1", "2", "3",
+	})
+
+	result := args.RestFloat64("N...", 0, -1, "the numbers")
+
+	chk.NoErr(args.Err())
+	chk.Float64Slice(result, []float64{1, 2, 3}, 0)
+
+	args = szargs.New("program description", []string{
+		"programName",
+		"1", "two",
+	})
+
+	result = args.RestFloat64("N...", 0, -1, "the numbers")
+
+	chk.Err(
+		args.Err(),
+		chk.ErrChain(
+			szargs.ErrInvalidFloat64,
+			szargs.ErrSyntax,
+			"N...",
+			"'two'",
+		),
+	)
+	chk.Float64Slice(result, nil, 0)
+}
+
+func TestSzargs_RestFloat32(t *testing.T) {
+	chk := sztestlog.CaptureNothing(t)
+	defer chk.Release()
+
+	args := szargs.New("program description", []string{
+		"programName",
+		"1", "2", "3",
+	})
+
+	result := args.RestFloat32("N...", 0, -1, "the numbers")
+
+	chk.NoErr(args.Err())
+	chk.Float32Slice(result, []float32{1, 2, 3}, 0)
+
+	args = szargs.New("program description", []string{
+		"programName",
+		"1", "two",
+	})
+
+	result = args.RestFloat32("N...", 0, -1, "the numbers")
+
+	chk.Err(
+		args.Err(),
+		chk.ErrChain(
+			szargs.ErrInvalidFloat32,
+			szargs.ErrSyntax,
+			"N...",
+			"'two'",
+		),
+	)
+	chk.Float32Slice(result, nil, 0)
+}
+
+func TestSzargs_RestInt64(t *testing.T) {
+	chk := sztestlog.CaptureNothing(t)
+	defer chk.Release()
+
+	args := szargs.New("program description", []string{
+		"programName",
+		"1", "2", "3",
+	})
+
+	result := args.RestInt64("N...", 0, -1, "the numbers")
+
+	chk.NoErr(args.Err())
+	chk.Int64Slice(result, []int64{1, 2, 3})
+
+	args = szargs.New("program description", []string{
+		"programName",
+		"1", "two",
+	})
+
+	result = args.RestInt64("N...", 0, -1, "the numbers")
+
+	chk.Err(
+		args.Err(),
+		chk.ErrChain(
+			szargs.ErrInvalidInt64,
+			szargs.ErrSyntax,
+			"N...",
+			"'two'",
+		),
+	)
+	chk.Int64Slice(result, nil)
+}
+
+func TestSzargs_RestInt32(t *testing.T) {
+	chk := sztestlog.CaptureNothing(t)
+	defer chk.Release()
+
+	args := szargs.New("program description", []string{
+		"programName",
+		"1", "2", "3",
+	})
+
+	result := args.RestInt32("N...", 0, -1, "the numbers")
+
+	chk.NoErr(args.Err())
+	chk.Int32Slice(result, []int32{1, 2, 3})
+
+	args = szargs.New("program description", []string{
+		"programName",
+		"1", "two",
+	})
+
+	result = args.RestInt32("N...", 0, -1, "the numbers")
+
+	chk.Err(
+		args.Err(),
+		chk.ErrChain(
+			szargs.ErrInvalidInt32,
+			szargs.ErrSyntax,
+			"N...",
+			"'two'",
+		),
+	)
+	chk.Int32Slice(result, nil)
+}
+
+func TestSzargs_RestInt16(t *testing.T) {
+	chk := sztestlog.CaptureNothing(t)
+	defer chk.Release()
+
+	args := szargs.New("program description", []string{
+		"programName",
+		"1", "2", "3",
+	})
+
+	result := args.RestInt16("N...", 0, -1, "the numbers")
+
+	chk.NoErr(args.Err())
+	chk.Int16Slice(result, []int16{1, 2, 3})
+
+	args = szargs.New("program description", []string{
+		"programName",
+		"1", "two",
+	})
+
+	result = args.RestInt16("N...", 0, -1, "the numbers")
+
+	chk.Err(
+		args.Err(),
+		chk.ErrChain(
+			szargs.ErrInvalidInt16,
+			szargs.ErrSyntax,
+			"N...",
+			"'two'",
+		),
+	)
+	chk.Int16Slice(result, nil)
+}
+
+func TestSzargs_RestInt8(t *testing.T) {
+	chk := sztestlog.CaptureNothing(t)
+	defer chk.Release()
+
+	args := szargs.New("program description", []string{
+		"programName",
+		"1", "2", "3",
+	})
+
+	result := args.RestInt8("N...", 0, -1, "the numbers")
+
+	chk.NoErr(args.Err())
+	chk.Int8Slice(result, []int8{1, 2, 3})
+
+	args = szargs.New("program description", []string{
+		"programName",
+		"1", "two",
+	})
+
+	result = args.RestInt8("N...", 0, -1, "the numbers")
+
+	chk.Err(
+		args.Err(),
+		chk.ErrChain(
+			szargs.ErrInvalidInt8,
+			szargs.ErrSyntax,
+			"N...",
+			"'two'",
+		),
+	)
+	chk.Int8Slice(result, nil)
+}
+
+func TestSzargs_RestUint64(t *testing.T) {
+	chk := sztestlog.CaptureNothing(t)
+	defer chk.Release()
+
+	args := szargs.New("program description", []string{
+		"programName",
+		"1", "2", "3",
+	})
+
+	result := args.RestUint64("N...", 0, -1, "the numbers")
+
+	chk.NoErr(args.Err())
+	chk.Uint64Slice(result, []uint64{1, 2, 3})
+
+	args = szargs.New("program description", []string{
+		"programName",
+		"1", "two",
+	})
+
+	result = args.RestUint64("N...", 0, -1, "the numbers")
+
+	chk.Err(
+		args.Err(),
+		chk.ErrChain(
+			szargs.ErrInvalidUint64,
+			szargs.ErrSyntax,
+			"N...",
+			"'two'",
+		),
+	)
+	chk.Uint64Slice(result, nil)
+}
+
+func TestSzargs_RestUint32(t *testing.T) {
+	chk := sztestlog.CaptureNothing(t)
+	defer chk.Release()
+
+	args := szargs.New("program description", []string{
+		"programName",
+		"1", "2", "3",
+	})
+
+	result := args.RestUint32("N...", 0, -1, "the numbers")
+
+	chk.NoErr(args.Err())
+	chk.Uint32Slice(result, []uint32{1, 2, 3})
+
+	args = szargs.New("program description", []string{
+		"programName",
+		"1", "two",
+	})
+
+	result = args.RestUint32("N...", 0, -1, "the numbers")
+
+	chk.Err(
+		args.Err(),
+		chk.ErrChain(
+			szargs.ErrInvalidUint32,
+			szargs.ErrSyntax,
+			"N...",
+			"'two'",
+		),
+	)
+	chk.Uint32Slice(result, nil)
+}
+
+func TestSzargs_RestUint16(t *testing.T) {
+	chk := sztestlog.CaptureNothing(t)
+	defer chk.Release()
+
+	args := szargs.New("program description", []string{
+		"programName",
+		"1", "2", "3",
+	})
+
+	result := args.RestUint16("N...", 0, -1, "the numbers")
+
+	chk.NoErr(args.Err())
+	chk.Uint16Slice(result, []uint16{1, 2, 3})
+
+	args = szargs.New("program description", []string{
+		"programName",
+		"1", "two",
+	})
+
+	result = args.RestUint16("N...", 0, -1, "the numbers")
+
+	chk.Err(
+		args.Err(),
+		chk.ErrChain(
+			szargs.ErrInvalidUint16,
+			szargs.ErrSyntax,
+			"N...",
+			"'two'",
+		),
+	)
+	chk.Uint16Slice(result, nil)
+}
+
+func TestSzargs_RestUint8(t *testing.T) {
+	chk := sztestlog.CaptureNothing(t)
+	defer chk.Release()
+
+	args := szargs.New("program description", []string{
+		"programName",
+		"1", "2", "3",
+	})
+
+	result := args.RestUint8("N...", 0, -1, "the numbers")
+
+	chk.NoErr(args.Err())
+	chk.Uint8Slice(result, []uint8{1, 2, 3})
+
+	args = szargs.New("program description", []string{
+		"programName",
+		"1", "two",
+	})
+
+	result = args.RestUint8("N...", 0, -1, "the numbers")
+
+	chk.Err(
+		args.Err(),
+		chk.ErrChain(
+			szargs.ErrInvalidUint8,
+			szargs.ErrSyntax,
+			"N...",
+			"'two'",
+		),
+	)
+	chk.Uint8Slice(result, nil)
+}
+
+func TestSzargs_RestUint(t *testing.T) {
+	chk := sztestlog.CaptureNothing(t)
+	defer chk.Release()
+
+	args := szargs.New("program description", []string{
+		"programName",
+		"1", "2", "3",
+	})
+
+	result := args.RestUint("N...", 0, -1, "the numbers")
+
+	chk.NoErr(args.Err())
+	chk.UintSlice(result, []uint{1, 2, 3})
+
+	args = szargs.New("program description", []string{
+		"programName",
+		"1", "two",
+	})
+
+	result = args.RestUint("N...", 0, -1, "the numbers")
+
+	chk.Err(
+		args.Err(),
+		chk.ErrChain(
+			szargs.ErrInvalidUint,
+			szargs.ErrSyntax,
+			"N...",
+			"'two'",
+		),
+	)
+	chk.UintSlice(result, nil)
+}
@@ -0,0 +1,34 @@
+/*
+   Szerszam argument library: szargs.
+   Copyright (C) 2024-2025  Leslie Dancsecs
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU General Public License for more details.
+
+   You should have received a copy of the GNU General Public License
+   along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package szargs
+
+// posixlyCorrectEnv names the environment variable enabling POSIXLY_CORRECT
+// mode when set.
+const posixlyCorrectEnv = "POSIXLY_CORRECT"
+
+// SetPosixlyCorrect enables or disables POSIXLY_CORRECT mode.  In this mode
+// flag scanning stops at the first positional argument leaving it and every
+// argument following it untouched (IE: the child arguments in "prog exec
+// sudo -u bob ls -l").  These arguments are only available through the Next
+// methods and Args.  A value following a flag is only recognized as such if
+// the flag is registered (see RegisterUsage).  Enabled by default if the
+// POSIXLY_CORRECT environment variable is set when New is called.
+func (args *Args) SetPosixlyCorrect(enabled bool) {
+	args.posixlyCorrect = enabled
+}
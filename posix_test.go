@@ -0,0 +1,112 @@
+/*
+   Szerszam argument library: szargs.
+   Copyright (C) 2024-2025  Leslie Dancsecs
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU General Public License for more details.
+
+   You should have received a copy of the GNU General Public License
+   along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package szargs_test
+
+import (
+	"testing"
+
+	"github.com/dancsecs/szargs"
+	"github.com/dancsecs/sztestlog"
+)
+
+func TestSzargs_PosixlyCorrect_Disabled(t *testing.T) {
+	chk := sztestlog.CaptureNothing(t)
+	defer chk.Release()
+
+	chk.DelEnv("POSIXLY_CORRECT")
+
+	args := szargs.New("program description", []string{
+		"programName",
+		"exec",
+		"-v",
+	})
+
+	chk.True(args.Is("[-v | --verbose]", "verbose"))
+	chk.StrSlice(args.Args(), []string{"exec"})
+}
+
+func TestSzargs_PosixlyCorrect(t *testing.T) {
+	chk := sztestlog.CaptureNothing(t)
+	defer chk.Release()
+
+	chk.DelEnv("POSIXLY_CORRECT")
+
+	args := szargs.New("program description", []string{
+		"programName",
+		"-n", "5",
+		"exec",
+		"sudo",
+		"-u", "bob",
+		"--",
+		"ls", "-v",
+	})
+
+	args.SetPosixlyCorrect(true)
+	args.RegisterUsage("[-n num]", "the number")
+
+	chk.False(args.Is("[-v | --verbose]", "verbose"))
+	chk.False(args.Is("[-u]", "the user"))
+
+	num, found := args.ValueInt("[-n num]", "the number")
+
+	chk.True(found)
+	chk.Int(num, 5)
+
+	chk.Str(args.NextString("command", "the command"), "exec")
+	chk.StrSlice(args.Args(), []string{
+		"sudo", "-u", "bob", "--", "ls", "-v",
+	})
+	chk.Str(args.NextString("program", "the program"), "sudo")
+	chk.Str(args.NextString("flag", "the flag"), "-u")
+	chk.Str(args.NextString("user", "the user"), "bob")
+	chk.Str(args.NextString("marker", "the marker"), "--")
+
+	chk.True(args.HasNext())
+
+	args.Done()
+
+	chk.Err(
+		args.Err(),
+		chk.ErrChain(
+			szargs.ErrUnexpected,
+			"[ls -v]",
+		),
+	)
+}
+
+func TestSzargs_PosixlyCorrect_Env(t *testing.T) {
+	chk := sztestlog.CaptureNothing(t)
+	defer chk.Release()
+
+	chk.SetEnv("POSIXLY_CORRECT", "")
+
+	args := szargs.New("program description", []string{
+		"programName",
+		"exec",
+		"-v",
+	})
+
+	chk.False(args.Is("[-v | --verbose]", "verbose"))
+	chk.StrSlice(args.Args(), []string{"exec", "-v"})
+
+	args.SetPosixlyCorrect(false)
+
+	chk.True(args.Is("[-v | --verbose]", "verbose"))
+	chk.StrSlice(args.Args(), []string{"exec"})
+}
@@ -0,0 +1,76 @@
+/*
+   Szerszam argument library: szargs.
+   Copyright (C) 2024-2025  Leslie Dancsecs
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU General Public License for more details.
+
+   You should have received a copy of the GNU General Public License
+   along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package szargs
+
+// RepeatPolicy determines how a repeated boolean flag or flag taking a single
+// value (or fixed number of values) is resolved.
+type RepeatPolicy int
+
+// Repeat policies.
+const (
+	// RepeatError registers an ErrAmbiguous error (the default).
+	RepeatError RepeatPolicy = iota
+	// RepeatFirstWins uses the first occurrence of the flag.
+	RepeatFirstWins
+	// RepeatLastWins uses the last occurrence of the flag.
+	RepeatLastWins
+)
+
+// SetRepeatPolicy sets the policy applied to every repeated flag without a
+// policy of its own.  With RepeatLastWins a later flag overrides an earlier
+// one (IE: "$BASE_FLAGS --level 3").  Flags extracted with the Count and
+// Values methods accept repeats regardless of the policy.
+func (args *Args) SetRepeatPolicy(policy RepeatPolicy) {
+	args.repeatPolicy = policy
+}
+
+// SetFlagRepeatPolicy sets the policy applied to the specified flag
+// overriding the policy set with SetRepeatPolicy.
+func (args *Args) SetFlagRepeatPolicy(flag string, policy RepeatPolicy) {
+	for _, name := range argFlag(flag).names() {
+		args.repeatPolicies[name] = policy
+	}
+}
+
+// repeatPolicyFor returns the policy applied to the flag.
+func (args *Args) repeatPolicyFor(flag string) RepeatPolicy {
+	for _, name := range argFlag(flag).names() {
+		if policy, ok := args.repeatPolicies[name]; ok {
+			return policy
+		}
+	}
+
+	return args.repeatPolicy
+}
+
+// apply returns the occurrences that determine a flag's result under the
+// policy.
+func (p RepeatPolicy) apply(occurrences []occurrence) []occurrence {
+	if len(occurrences) > 1 {
+		switch p {
+		case RepeatFirstWins:
+			return occurrences[:1]
+		case RepeatLastWins:
+			return occurrences[len(occurrences)-1:]
+		case RepeatError:
+		}
+	}
+
+	return occurrences
+}
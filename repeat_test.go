@@ -0,0 +1,158 @@
+/*
+   Szerszam argument library: szargs.
+   Copyright (C) 2024-2025  Leslie Dancsecs
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU General Public License for more details.
+
+   You should have received a copy of the GNU General Public License
+   along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package szargs_test
+
+import (
+	"testing"
+
+	"github.com/dancsecs/szargs"
+	"github.com/dancsecs/sztestlog"
+)
+
+func TestSzargs_RepeatPolicy_Error(t *testing.T) {
+	chk := sztestlog.CaptureNothing(t)
+	defer chk.Release()
+
+	args := szargs.New("program description", []string{
+		"programName",
+		"--level", "1",
+		"--level", "3",
+	})
+
+	level, found := args.ValueInt("[--level n]", "the level")
+
+	chk.Err(
+		args.Err(),
+		chk.ErrChain(
+			szargs.ErrAmbiguous,
+			"'[--level n]' for '3' already set to: '1'",
+		),
+	)
+	chk.False(found)
+	chk.Int(level, 0)
+	chk.StrSlice(args.Args(), nil)
+}
+
+func TestSzargs_RepeatPolicy_LastWins(t *testing.T) {
+	chk := sztestlog.CaptureNothing(t)
+	defer chk.Release()
+
+	args := szargs.New("program description", []string{
+		"programName",
+		"--level", "1",
+		"-v",
+		"--level=3",
+		"-v",
+		"--rename", "a", "b",
+		"--rename", "c", "d",
+	})
+
+	args.SetRepeatPolicy(szargs.RepeatLastWins)
+
+	level, found := args.ValueInt("[--level n]", "the level")
+
+	chk.True(found)
+	chk.Int(level, 3)
+
+	chk.True(args.Is("[-v]", "verbose"))
+	chk.StrSlice(
+		args.ValueStrings("[--rename old new]", 2, "rename"),
+		[]string{"c", "d"},
+	)
+
+	chk.NoErr(args.Err())
+	chk.StrSlice(args.Args(), nil)
+}
+
+func TestSzargs_RepeatPolicy_FirstWins(t *testing.T) {
+	chk := sztestlog.CaptureNothing(t)
+	defer chk.Release()
+
+	args := szargs.New("program description", []string{
+		"programName",
+		"--level", "1",
+		"--no-color",
+		"--level", "3",
+		"--color",
+	})
+
+	args.SetRepeatPolicy(szargs.RepeatFirstWins)
+
+	level, found := args.ValueInt("[--level n]", "the level")
+
+	chk.True(found)
+	chk.Int(level, 1)
+
+	chk.False(args.Is("[--[no-]color]", "colorize"))
+
+	chk.NoErr(args.Err())
+	chk.StrSlice(args.Args(), nil)
+}
+
+func TestSzargs_RepeatPolicy_PerFlag(t *testing.T) {
+	chk := sztestlog.CaptureNothing(t)
+	defer chk.Release()
+
+	args := szargs.New("program description", []string{
+		"programName",
+		"-l", "1",
+		"-n", "a",
+		"--level", "3",
+		"-n", "b",
+	})
+
+	args.SetFlagRepeatPolicy("[-l | --level n]", szargs.RepeatLastWins)
+
+	level, found := args.ValueInt("[-l | --level n]", "the level")
+
+	chk.True(found)
+	chk.Int(level, 3)
+
+	name, found := args.ValueString("[-n name]", "the name")
+
+	chk.Err(
+		args.Err(),
+		chk.ErrChain(
+			szargs.ErrAmbiguous,
+			"'[-n name]' for 'b' already set to: 'a'",
+		),
+	)
+	chk.False(found)
+	chk.Str(name, "")
+}
+
+func TestSzargs_RepeatPolicy_Setting(t *testing.T) {
+	chk := sztestlog.CaptureNothing(t)
+	defer chk.Release()
+
+	chk.SetEnv(tstEnvArgs, "--level 1")
+
+	args := szargs.New("program description", []string{
+		"programName",
+		"--level", "3",
+	})
+
+	args.PrependEnvArgs(tstEnvArgs)
+	args.SetRepeatPolicy(szargs.RepeatLastWins)
+
+	chk.Int(args.SettingInt("[--level n]", "", 2, "the level"), 3)
+
+	chk.NoErr(args.Err())
+	chk.StrSlice(args.Args(), nil)
+}
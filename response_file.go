@@ -0,0 +1,173 @@
+/*
+   Szerszam argument library: szargs.
+   Copyright (C) 2024-2025  Leslie Dancsecs
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU General Public License for more details.
+
+   You should have received a copy of the GNU General Public License
+   along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package szargs
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+)
+
+// responseFilePrefix marks an argument naming a response file.  Doubling it
+// (IE: "@@name") escapes an argument starting with a literal '@'.
+const responseFilePrefix = "@"
+
+// maxResponseFileDepth limits how deeply response files may include other
+// response files.
+const maxResponseFileDepth = 16
+
+// ExpandResponseFiles replaces every argument of the form "@file" with the
+// arguments read from the named file.  The file is split into arguments using
+// shell-like quoting rules: white space separates arguments, single and double
+// quotes group them and a backslash escapes the following character.  An
+// unquoted '#' starting an argument begins a comment running to the end of the
+// line.  Response files may themselves include other response files up to a
+// depth of 16.  Relative file names are resolved against the current working
+// directory.  An argument starting with "@@" is passed on with its first '@'
+// removed and arguments following the end of options marker ("--") are never
+// expanded.
+//
+// It should be called immediately after New before any arguments are
+// extracted.  Missing or unreadable files, syntax errors and files including
+// themselves register an error naming the file and line at fault.
+func (args *Args) ExpandResponseFiles() {
+	words := make([]shellWord, len(args.args))
+
+	for i, arg := range args.args {
+		words[i] = shellWord{text: arg, line: 0}
+	}
+
+	expander := &responseFiles{
+		open:       nil,
+		terminated: false,
+		args:       args,
+	}
+
+	args.setArgs(expander.expand("", words, args.origins))
+}
+
+// responseFiles tracks the state of a response file expansion.
+type responseFiles struct {
+	open       []string
+	terminated bool
+	args       *Args
+}
+
+// expand returns the words with all response files replaced by their
+// contents along with the origins of the resulting arguments.  The source
+// names the file the words were read from and is empty for the program
+// arguments.
+func (r *responseFiles) expand(
+	source string, words []shellWord, origins []origin,
+) ([]string, []origin) {
+	var (
+		result        []string
+		resultOrigins []origin
+	)
+
+	for i, word := range words {
+		arg := word.text
+
+		switch {
+		case r.terminated ||
+			arg == responseFilePrefix ||
+			!strings.HasPrefix(arg, responseFilePrefix):
+			r.terminated = r.terminated || arg == endOfOptions
+			result = append(result, arg)
+			resultOrigins = append(resultOrigins, origins[i])
+		case strings.HasPrefix(arg, responseFilePrefix+responseFilePrefix):
+			result = append(result, arg[len(responseFilePrefix):])
+			resultOrigins = append(resultOrigins, origins[i])
+		default:
+			included, includedOrigins := r.include(source, word, origins[i])
+			result = append(result, included...)
+			resultOrigins = append(resultOrigins, includedOrigins...)
+		}
+	}
+
+	return result, resultOrigins
+}
+
+// include returns the expanded contents of the response file named by the
+// word along with their origins derived from the origin of the word.
+func (r *responseFiles) include(
+	source string, word shellWord, from origin,
+) ([]string, []origin) {
+	path := strings.TrimPrefix(word.text, responseFilePrefix)
+
+	where := "'" + word.text + "'"
+	if source != "" {
+		where = fmt.Sprintf("%s:%d", source, word.line)
+	}
+
+	absPath, err := filepath.Abs(path)
+	if err != nil {
+		absPath = path
+	}
+
+	if slices.Contains(r.open, absPath) {
+		r.args.PushErr(
+			fmt.Errorf(
+				"%w: %s: cycle including '%s'", ErrResponseFile, where, path,
+			),
+		)
+
+		return nil, nil
+	}
+
+	if len(r.open) >= maxResponseFileDepth {
+		r.args.PushErr(
+			fmt.Errorf(
+				"%w: %s: '%s' nested deeper than %d files",
+				ErrResponseFile,
+				where,
+				path,
+				maxResponseFileDepth,
+			),
+		)
+
+		return nil, nil
+	}
+
+	data, err := os.ReadFile(path) //nolint:gosec // Ok.
+	if err != nil {
+		r.args.PushErr(fmt.Errorf("%w: %s: %w", ErrResponseFile, where, err))
+
+		return nil, nil
+	}
+
+	words, line, err := splitShell(string(data))
+	if err != nil {
+		r.args.PushErr(
+			fmt.Errorf("%w: %s:%d: %w", ErrResponseFile, path, line, err),
+		)
+
+		return nil, nil
+	}
+
+	r.open = append(r.open, absPath)
+	result, origins := r.expand(
+		path, words, r.args.newOrigins(len(words), from),
+	)
+	r.open = r.open[:len(r.open)-1]
+
+	return result, origins
+}
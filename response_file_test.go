@@ -0,0 +1,188 @@
+/*
+   Szerszam argument library: szargs.
+   Copyright (C) 2024-2025  Leslie Dancsecs
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU General Public License for more details.
+
+   You should have received a copy of the GNU General Public License
+   along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package szargs_test
+
+import (
+	"os"
+	"path/filepath"
+	"strconv"
+	"testing"
+
+	"github.com/dancsecs/szargs"
+	"github.com/dancsecs/sztestlog"
+)
+
+func writeResponseFile(t *testing.T, dir, name, content string) string {
+	t.Helper()
+
+	path := filepath.Join(dir, name)
+
+	err := os.WriteFile(path, []byte(content), 0o600)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return path
+}
+
+func TestSzargs_ExpandResponseFiles(t *testing.T) {
+	chk := sztestlog.CaptureNothing(t)
+	defer chk.Release()
+
+	dir := t.TempDir()
+	inner := writeResponseFile(t, dir, "inner.txt", "-n 'two words'\n")
+	outer := writeResponseFile(t, dir, "outer.txt",
+		"# Build options.\n-v\n@"+inner+"\n\"@@literal\"\n",
+	)
+
+	args := szargs.New("program description", []string{
+		"programName",
+		"first",
+		"@" + outer,
+		"@@at",
+		"@",
+		"--",
+		"@" + inner,
+	})
+
+	args.ExpandResponseFiles()
+
+	chk.NoErr(args.Err())
+	chk.StrSlice(args.Args(), []string{
+		"first",
+		"-v", "-n", "two words", "@literal",
+		"@at",
+		"@",
+		"--",
+		"@" + inner,
+	})
+}
+
+func TestSzargs_ExpandResponseFiles_Missing(t *testing.T) {
+	chk := sztestlog.CaptureNothing(t)
+	defer chk.Release()
+
+	dir := t.TempDir()
+	missing := filepath.Join(dir, "missing.txt")
+	outer := writeResponseFile(t, dir, "outer.txt", "-v\n\n@"+missing+"\n")
+
+	args := szargs.New("program description", []string{
+		"programName",
+		"@" + outer,
+	})
+
+	args.ExpandResponseFiles()
+
+	chk.Err(
+		args.Err(),
+		chk.ErrChain(
+			szargs.ErrResponseFile,
+			outer+":3",
+			"open "+missing+": no such file or directory",
+		),
+	)
+	chk.StrSlice(args.Args(), []string{"-v"})
+}
+
+func TestSzargs_ExpandResponseFiles_Syntax(t *testing.T) {
+	chk := sztestlog.CaptureNothing(t)
+	defer chk.Release()
+
+	dir := t.TempDir()
+	bad := writeResponseFile(t, dir, "bad.txt", "-v\n-n 'open\n")
+
+	args := szargs.New("program description", []string{
+		"programName",
+		"@" + bad,
+		"last",
+	})
+
+	args.ExpandResponseFiles()
+
+	chk.Err(
+		args.Err(),
+		chk.ErrChain(
+			szargs.ErrResponseFile,
+			bad+":2",
+			szargs.ErrSyntax,
+			"unterminated quote (')",
+		),
+	)
+	chk.StrSlice(args.Args(), []string{"last"})
+}
+
+func TestSzargs_ExpandResponseFiles_Cycle(t *testing.T) {
+	chk := sztestlog.CaptureNothing(t)
+	defer chk.Release()
+
+	dir := t.TempDir()
+	first := filepath.Join(dir, "first.txt")
+	second := writeResponseFile(t, dir, "second.txt", "b\n@"+first+"\n")
+	writeResponseFile(t, dir, "first.txt", "a @"+second+"\n")
+
+	args := szargs.New("program description", []string{
+		"programName",
+		"@" + first,
+	})
+
+	args.ExpandResponseFiles()
+
+	chk.Err(
+		args.Err(),
+		chk.ErrChain(
+			szargs.ErrResponseFile,
+			second+":2",
+			"cycle including '"+first+"'",
+		),
+	)
+	chk.StrSlice(args.Args(), []string{"a", "b"})
+}
+
+func TestSzargs_ExpandResponseFiles_Depth(t *testing.T) {
+	chk := sztestlog.CaptureNothing(t)
+	defer chk.Release()
+
+	dir := t.TempDir()
+	next := writeResponseFile(t, dir, "file17.txt", "deepest\n")
+
+	for i := 16; i > 0; i-- {
+		next = writeResponseFile(t, dir,
+			"file"+strconv.Itoa(i)+".txt",
+			"@"+next+"\n",
+		)
+	}
+
+	args := szargs.New("program description", []string{
+		"programName",
+		"@" + next,
+	})
+
+	args.ExpandResponseFiles()
+
+	chk.Err(
+		args.Err(),
+		chk.ErrChain(
+			szargs.ErrResponseFile,
+			filepath.Join(dir, "file16.txt")+":1",
+			"'"+filepath.Join(dir, "file17.txt")+
+				"' nested deeper than 16 files",
+		),
+	)
+	chk.StrSlice(args.Args(), nil)
+}
@@ -0,0 +1,134 @@
+/*
+   Szerszam argument library: szargs.
+   Copyright (C) 2024-2025  Leslie Dancsecs
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU General Public License for more details.
+
+   You should have received a copy of the GNU General Public License
+   along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package szargs
+
+import (
+	"fmt"
+	"maps"
+	"slices"
+)
+
+// Split divides the remaining arguments into segments at every occurrence of
+// the delimiter (IE: ";" or "--") returning a child Args for each segment in
+// order.  The delimiters are discarded and the parent retains no arguments.
+// An empty segment (IE: a trailing delimiter) results in a child without
+// arguments.  The value of a registered flag is never taken as a delimiter.
+// An end of options marker ("--") ends the splitting unless it is the
+// delimiter with the marker and every argument following it left in the
+// current segment.
+//
+// Each child has its own usage registration and must be checked with its own
+// Done method.  The child inherits the parent's program name, description and
+// configuration (abbreviations, list separator, POSIXLY_CORRECT mode, repeat
+// policies and dialect).  Errors registered with the children are reported by
+// the parent's Err method following its own errors in segment order.
+func (args *Args) Split(delimiter string) []*Args {
+	var (
+		segments       [][]string
+		segment        []string
+		segmentOrigins [][]origin
+		origins        []origin
+	)
+
+	args.tokenize()
+
+	limit := len(args.args)
+	if args.terminated {
+		limit = 0
+	}
+
+	for i := 0; i < len(args.args); i++ {
+		arg := args.args[i]
+
+		if i < limit && arg == delimiter {
+			segments = append(segments, segment)
+			segmentOrigins = append(segmentOrigins, origins)
+			segment, origins = nil, nil
+
+			continue
+		}
+
+		last := i
+
+		switch {
+		case i >= limit:
+		case arg == endOfOptions:
+			limit = i
+		case isFlagName(arg):
+			// Keep any values belonging to the flag.
+			last = min(i+max(args.flags[arg], 0), len(args.args)-1)
+		}
+
+		segment = append(segment, args.args[i:last+1]...)
+		origins = append(origins, args.origins[i:last+1]...)
+		i = last
+	}
+
+	segments = append(segments, segment)
+	segmentOrigins = append(segmentOrigins, origins)
+	args.setArgs(nil, nil)
+
+	children := make([]*Args, len(segments))
+
+	for i, segment := range segments {
+		child := New("", append([]string{args.programName}, segment...))
+		child.setArgs(child.args, segmentOrigins[i])
+		child.nextOrigin = args.nextOrigin
+		child.terminated = args.terminated
+		child.envArgsName = args.envArgsName
+		child.programDesc = args.programDesc
+		child.lineWidth = args.lineWidth
+		child.abbreviate = args.abbreviate
+		child.listSeparator = args.listSeparator
+		child.posixlyCorrect = args.posixlyCorrect
+		child.repeatPolicy = args.repeatPolicy
+		child.repeatPolicies = maps.Clone(args.repeatPolicies)
+		child.dialect = args.dialect
+		child.durationUnit = args.durationUnit
+		child.timeLayouts = slices.Clone(args.timeLayouts)
+		child.timeLocation = args.timeLocation
+
+		children[i] = child
+	}
+
+	args.children = append(args.children, children...)
+
+	return children
+}
+
+// segmentErrs returns the errors registered with the child segments in order
+// with each identifying its segment.
+func (args *Args) segmentErrs(err error) error {
+	for i, child := range args.children {
+		childErr := child.Err()
+		if childErr == nil {
+			continue
+		}
+
+		childErr = fmt.Errorf("%w: %d: %w", ErrSegment, i+1, childErr)
+
+		if err == nil {
+			err = childErr
+		} else {
+			err = fmt.Errorf("%w: %w", err, childErr)
+		}
+	}
+
+	return err
+}
@@ -0,0 +1,174 @@
+/*
+   Szerszam argument library: szargs.
+   Copyright (C) 2024-2025  Leslie Dancsecs
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU General Public License for more details.
+
+   You should have received a copy of the GNU General Public License
+   along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package szargs_test
+
+import (
+	"testing"
+
+	"github.com/dancsecs/szargs"
+	"github.com/dancsecs/sztestlog"
+)
+
+func TestSzargs_Split(t *testing.T) {
+	chk := sztestlog.CaptureNothing(t)
+	defer chk.Release()
+
+	args := szargs.New("program description", []string{
+		"programName",
+		"-v",
+		"step1", "--x", "1",
+		"--",
+		"step2", "--y", "2",
+	})
+
+	chk.True(args.Is("[-v]", "verbose"))
+
+	segments := args.Split("--")
+
+	chk.Int(len(segments), 2)
+	chk.StrSlice(args.Args(), nil)
+
+	x, found := segments[0].ValueInt("[--x n]", "the x")
+	chk.True(found)
+	chk.Int(x, 1)
+	chk.Str(segments[0].NextString("step", "the step"), "step1")
+	segments[0].Done()
+
+	y, found := segments[1].ValueInt("[--y n]", "the y")
+	chk.True(found)
+	chk.Int(y, 2)
+	chk.Str(segments[1].NextString("step", "the step"), "step2")
+	segments[1].Done()
+
+	args.Done()
+
+	chk.NoErr(args.Err())
+	chk.False(args.HasErr())
+}
+
+func TestSzargs_Split_Empty(t *testing.T) {
+	chk := sztestlog.CaptureNothing(t)
+	defer chk.Release()
+
+	args := szargs.New("program description", []string{
+		"programName",
+		"-exec", "ls", "{}", ";",
+	})
+
+	segments := args.Split(";")
+
+	chk.Int(len(segments), 2)
+	chk.StrSlice(segments[0].Args(), []string{"-exec", "ls", "{}"})
+	chk.StrSlice(segments[1].Args(), nil)
+}
+
+func TestSzargs_Split_FlagValue(t *testing.T) {
+	chk := sztestlog.CaptureNothing(t)
+	defer chk.Release()
+
+	args := szargs.New("program description", []string{
+		"programName",
+		"--sep", ";",
+		"x",
+		";",
+		"y",
+	})
+
+	args.RegisterUsage("[--sep s]", "the separator")
+
+	segments := args.Split(";")
+
+	chk.Int(len(segments), 2)
+	chk.StrSlice(segments[0].Args(), []string{"--sep", ";", "x"})
+	chk.StrSlice(segments[1].Args(), []string{"y"})
+
+	sep, found := segments[0].ValueString("[--sep s]", "the separator")
+	chk.True(found)
+	chk.Str(sep, ";")
+}
+
+func TestSzargs_Split_EndOfOptions(t *testing.T) {
+	chk := sztestlog.CaptureNothing(t)
+	defer chk.Release()
+
+	args := szargs.New("program description", []string{
+		"programName",
+		"a",
+		";",
+		"b",
+		"--",
+		"c",
+		";",
+		"d",
+	})
+
+	segments := args.Split(";")
+
+	chk.Int(len(segments), 2)
+	chk.StrSlice(segments[0].Args(), []string{"a"})
+	chk.StrSlice(segments[1].Args(), []string{"b", "--", "c", ";", "d"})
+	chk.StrSlice(
+		segments[1].RestString("rest", 0, -1, "the rest"),
+		[]string{"b", "c", ";", "d"},
+	)
+}
+
+func TestSzargs_Split_Errors(t *testing.T) {
+	chk := sztestlog.CaptureNothing(t)
+	defer chk.Release()
+
+	args := szargs.New("program description", []string{
+		"programName",
+		"--x", "one",
+		";",
+		"--x", "1",
+		";",
+		"--x", "3", "extra",
+	})
+
+	segments := args.Split(";")
+
+	chk.Int(len(segments), 3)
+
+	// Errors are reported in segment order regardless of processing order.
+	for i := len(segments) - 1; i >= 0; i-- {
+		segments[i].ValueInt("[--x n]", "the x")
+		segments[i].Done()
+	}
+
+	args.PushErr(szargs.ErrNoArgs)
+
+	chk.True(args.HasErr())
+	chk.Err(
+		args.Err(),
+		chk.ErrChain(
+			szargs.ErrNoArgs,
+			szargs.ErrSegment,
+			"1",
+			szargs.ErrInvalidInt,
+			szargs.ErrSyntax,
+			"[--x n]",
+			"'one'",
+			szargs.ErrSegment,
+			"3",
+			szargs.ErrUnexpected,
+			"[extra]",
+		),
+	)
+}
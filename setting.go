@@ -33,11 +33,11 @@ const defaultStandIn = "~~--default--~~"
 // the returned argument list.  An error is returned if the argument is missing
 // or ambiguous.
 func setting(
-	flag, env, def string, args []string,
+	flag, env, def string, args []string, reg registry, policy RepeatPolicy,
 ) (string, []string, error, error) {
 	srcErr := ErrInvalidFlag
 
-	value, found, cleanArgs, err := argFlag(flag).value(args)
+	value, found, cleanArgs, err := argFlag(flag).value(args, reg, policy)
 	if err != nil {
 		return "", args, srcErr, err
 	}
@@ -67,8 +67,7 @@ func (args *Args) SettingString(
 	flag, env, def, desc string,
 ) string {
 	args.RegisterUsage(flag, desc)
-	result, cleanedArgs, srcErr, err := setting(flag, env, def, args.Args())
-	args.args = cleanedArgs
+	result, _, srcErr, err := args.scanSetting(flag, env, def)
 
 	if err != nil {
 		args.PushErr(srcErr)
@@ -90,43 +89,7 @@ func (args *Args) SettingString(
 func (args *Args) SettingFloat64(
 	flag, env string, def float64, desc string,
 ) float64 {
-	var (
-		value       string
-		cleanedArgs []string
-		result      float64
-		parseName   string
-		srcErr      error
-		err         error
-	)
-
-	args.RegisterUsage(flag, desc)
-
-	value, cleanedArgs, srcErr, err = setting(
-		flag, env, defaultStandIn, args.Args(),
-	)
-
-	if err == nil { //nolint:nestif // Ok.
-		if value == defaultStandIn {
-			result = def
-		} else {
-			if errors.Is(srcErr, ErrInvalidEnv) {
-				parseName = env
-			} else {
-				parseName = flag
-			}
-
-			result, err = parseFloat64(parseName, value)
-		}
-	}
-
-	args.args = cleanedArgs
-
-	if err != nil {
-		args.PushErr(srcErr)
-		args.PushErr(err)
-	}
-
-	return result
+	return Setting(args, ParseFloat64, flag, env, def, desc)
 }
 
 // SettingFloat32 returns a configuration value based on a default, optionally
@@ -141,43 +104,7 @@ func (args *Args) SettingFloat64(
 func (args *Args) SettingFloat32(
 	flag, env string, def float32, desc string,
 ) float32 {
-	var (
-		value       string
-		cleanedArgs []string
-		result      float32
-		parseName   string
-		srcErr      error
-		err         error
-	)
-
-	args.RegisterUsage(flag, desc)
-
-	value, cleanedArgs, srcErr, err = setting(
-		flag, env, defaultStandIn, args.Args(),
-	)
-
-	if err == nil { //nolint:nestif // Ok.
-		if value == defaultStandIn {
-			result = def
-		} else {
-			if errors.Is(srcErr, ErrInvalidEnv) {
-				parseName = env
-			} else {
-				parseName = flag
-			}
-
-			result, err = parseFloat32(parseName, value)
-		}
-	}
-
-	args.args = cleanedArgs
-
-	if err != nil {
-		args.PushErr(srcErr)
-		args.PushErr(err)
-	}
-
-	return result
+	return Setting(args, ParseFloat32, flag, env, def, desc)
 }
 
 // SettingInt64 returns a configuration value based on a default, optionally
@@ -191,43 +118,7 @@ func (args *Args) SettingFloat32(
 func (args *Args) SettingInt64(
 	flag, env string, def int64, desc string,
 ) int64 {
-	var (
-		value       string
-		cleanedArgs []string
-		result      int64
-		parseName   string
-		srcErr      error
-		err         error
-	)
-
-	args.RegisterUsage(flag, desc)
-
-	value, cleanedArgs, srcErr, err = setting(
-		flag, env, defaultStandIn, args.Args(),
-	)
-
-	if err == nil { //nolint:nestif // Ok.
-		if value == defaultStandIn {
-			result = def
-		} else {
-			if errors.Is(srcErr, ErrInvalidEnv) {
-				parseName = env
-			} else {
-				parseName = flag
-			}
-
-			result, err = parseInt64(parseName, value)
-		}
-	}
-
-	args.args = cleanedArgs
-
-	if err != nil {
-		args.PushErr(srcErr)
-		args.PushErr(err)
-	}
-
-	return result
+	return Setting(args, ParseInt64, flag, env, def, desc)
 }
 
 // SettingInt32 returns a configuration value based on a default, optionally
@@ -241,43 +132,7 @@ func (args *Args) SettingInt64(
 func (args *Args) SettingInt32(
 	flag, env string, def int32, desc string,
 ) int32 {
-	var (
-		value       string
-		cleanedArgs []string
-		result      int32
-		parseName   string
-		srcErr      error
-		err         error
-	)
-
-	args.RegisterUsage(flag, desc)
-
-	value, cleanedArgs, srcErr, err = setting(
-		flag, env, defaultStandIn, args.Args(),
-	)
-
-	if err == nil { //nolint:nestif // Ok.
-		if value == defaultStandIn {
-			result = def
-		} else {
-			if errors.Is(srcErr, ErrInvalidEnv) {
-				parseName = env
-			} else {
-				parseName = flag
-			}
-
-			result, err = parseInt32(parseName, value)
-		}
-	}
-
-	args.args = cleanedArgs
-
-	if err != nil {
-		args.PushErr(srcErr)
-		args.PushErr(err)
-	}
-
-	return result
+	return Setting(args, ParseInt32, flag, env, def, desc)
 }
 
 // SettingInt16 returns a configuration value based on a default, optionally
@@ -291,43 +146,7 @@ func (args *Args) SettingInt32(
 func (args *Args) SettingInt16(
 	flag, env string, def int16, desc string,
 ) int16 {
-	var (
-		value       string
-		cleanedArgs []string
-		result      int16
-		parseName   string
-		srcErr      error
-		err         error
-	)
-
-	args.RegisterUsage(flag, desc)
-
-	value, cleanedArgs, srcErr, err = setting(
-		flag, env, defaultStandIn, args.Args(),
-	)
-
-	if err == nil { //nolint:nestif // Ok.
-		if value == defaultStandIn {
-			result = def
-		} else {
-			if errors.Is(srcErr, ErrInvalidEnv) {
-				parseName = env
-			} else {
-				parseName = flag
-			}
-
-			result, err = parseInt16(parseName, value)
-		}
-	}
-
-	args.args = cleanedArgs
-
-	if err != nil {
-		args.PushErr(srcErr)
-		args.PushErr(err)
-	}
-
-	return result
+	return Setting(args, ParseInt16, flag, env, def, desc)
 }
 
 // SettingInt8 returns a configuration value based on a default, optionally
@@ -341,43 +160,7 @@ func (args *Args) SettingInt16(
 func (args *Args) SettingInt8(
 	flag, env string, def int8, desc string,
 ) int8 {
-	var (
-		value       string
-		cleanedArgs []string
-		result      int8
-		parseName   string
-		srcErr      error
-		err         error
-	)
-
-	args.RegisterUsage(flag, desc)
-
-	value, cleanedArgs, srcErr, err = setting(
-		flag, env, defaultStandIn, args.Args(),
-	)
-
-	if err == nil { //nolint:nestif // Ok.
-		if value == defaultStandIn {
-			result = def
-		} else {
-			if errors.Is(srcErr, ErrInvalidEnv) {
-				parseName = env
-			} else {
-				parseName = flag
-			}
-
-			result, err = parseInt8(parseName, value)
-		}
-	}
-
-	args.args = cleanedArgs
-
-	if err != nil {
-		args.PushErr(srcErr)
-		args.PushErr(err)
-	}
-
-	return result
+	return Setting(args, ParseInt8, flag, env, def, desc)
 }
 
 // SettingInt returns a configuration value based on a default, optionally
@@ -391,43 +174,7 @@ func (args *Args) SettingInt8(
 func (args *Args) SettingInt(
 	flag, env string, def int, desc string,
 ) int {
-	var (
-		value       string
-		cleanedArgs []string
-		result      int
-		parseName   string
-		srcErr      error
-		err         error
-	)
-
-	args.RegisterUsage(flag, desc)
-
-	value, cleanedArgs, srcErr, err = setting(
-		flag, env, defaultStandIn, args.Args(),
-	)
-
-	if err == nil { //nolint:nestif // Ok.
-		if value == defaultStandIn {
-			result = def
-		} else {
-			if errors.Is(srcErr, ErrInvalidEnv) {
-				parseName = env
-			} else {
-				parseName = flag
-			}
-
-			result, err = parseInt(parseName, value)
-		}
-	}
-
-	args.args = cleanedArgs
-
-	if err != nil {
-		args.PushErr(srcErr)
-		args.PushErr(err)
-	}
-
-	return result
+	return Setting(args, ParseInt, flag, env, def, desc)
 }
 
 // SettingUint64 returns a configuration value based on a default, optionally
@@ -441,43 +188,7 @@ func (args *Args) SettingInt(
 func (args *Args) SettingUint64(
 	flag, env string, def uint64, desc string,
 ) uint64 {
-	var (
-		value       string
-		cleanedArgs []string
-		result      uint64
-		parseName   string
-		srcErr      error
-		err         error
-	)
-
-	args.RegisterUsage(flag, desc)
-
-	value, cleanedArgs, srcErr, err = setting(
-		flag, env, defaultStandIn, args.Args(),
-	)
-
-	if err == nil { //nolint:nestif // Ok.
-		if value == defaultStandIn {
-			result = def
-		} else {
-			if errors.Is(srcErr, ErrInvalidEnv) {
-				parseName = env
-			} else {
-				parseName = flag
-			}
-
-			result, err = parseUint64(parseName, value)
-		}
-	}
-
-	args.args = cleanedArgs
-
-	if err != nil {
-		args.PushErr(srcErr)
-		args.PushErr(err)
-	}
-
-	return result
+	return Setting(args, ParseUint64, flag, env, def, desc)
 }
 
 // SettingUint32 returns a configuration value based on a default, optionally
@@ -491,43 +202,7 @@ func (args *Args) SettingUint64(
 func (args *Args) SettingUint32(
 	flag, env string, def uint32, desc string,
 ) uint32 {
-	var (
-		value       string
-		cleanedArgs []string
-		result      uint32
-		parseName   string
-		srcErr      error
-		err         error
-	)
-
-	args.RegisterUsage(flag, desc)
-
-	value, cleanedArgs, srcErr, err = setting(
-		flag, env, defaultStandIn, args.Args(),
-	)
-
-	if err == nil { //nolint:nestif // Ok.
-		if value == defaultStandIn {
-			result = def
-		} else {
-			if errors.Is(srcErr, ErrInvalidEnv) {
-				parseName = env
-			} else {
-				parseName = flag
-			}
-
-			result, err = parseUint32(parseName, value)
-		}
-	}
-
-	args.args = cleanedArgs
-
-	if err != nil {
-		args.PushErr(srcErr)
-		args.PushErr(err)
-	}
-
-	return result
+	return Setting(args, ParseUint32, flag, env, def, desc)
 }
 
 // SettingUint16 returns a configuration value based on a default, optionally
@@ -541,43 +216,7 @@ func (args *Args) SettingUint32(
 func (args *Args) SettingUint16(
 	flag, env string, def uint16, desc string,
 ) uint16 {
-	var (
-		value       string
-		cleanedArgs []string
-		result      uint16
-		parseName   string
-		srcErr      error
-		err         error
-	)
-
-	args.RegisterUsage(flag, desc)
-
-	value, cleanedArgs, srcErr, err = setting(
-		flag, env, defaultStandIn, args.Args(),
-	)
-
-	if err == nil { //nolint:nestif // Ok.
-		if value == defaultStandIn {
-			result = def
-		} else {
-			if errors.Is(srcErr, ErrInvalidEnv) {
-				parseName = env
-			} else {
-				parseName = flag
-			}
-
-			result, err = parseUint16(parseName, value)
-		}
-	}
-
-	args.args = cleanedArgs
-
-	if err != nil {
-		args.PushErr(srcErr)
-		args.PushErr(err)
-	}
-
-	return result
+	return Setting(args, ParseUint16, flag, env, def, desc)
 }
 
 // SettingUint8 returns a configuration value based on a default, optionally
@@ -591,43 +230,7 @@ func (args *Args) SettingUint16(
 func (args *Args) SettingUint8(
 	flag, env string, def uint8, desc string,
 ) uint8 {
-	var (
-		value       string
-		cleanedArgs []string
-		result      uint8
-		parseName   string
-		srcErr      error
-		err         error
-	)
-
-	args.RegisterUsage(flag, desc)
-
-	value, cleanedArgs, srcErr, err = setting(
-		flag, env, defaultStandIn, args.Args(),
-	)
-
-	if err == nil { //nolint:nestif // Ok.
-		if value == defaultStandIn {
-			result = def
-		} else {
-			if errors.Is(srcErr, ErrInvalidEnv) {
-				parseName = env
-			} else {
-				parseName = flag
-			}
-
-			result, err = parseUint8(parseName, value)
-		}
-	}
-
-	args.args = cleanedArgs
-
-	if err != nil {
-		args.PushErr(srcErr)
-		args.PushErr(err)
-	}
-
-	return result
+	return Setting(args, ParseUint8, flag, env, def, desc)
 }
 
 // SettingUint returns a configuration value based on a default, optionally
@@ -641,43 +244,7 @@ func (args *Args) SettingUint8(
 func (args *Args) SettingUint(
 	flag, env string, def uint, desc string,
 ) uint {
-	var (
-		value       string
-		cleanedArgs []string
-		result      uint
-		parseName   string
-		srcErr      error
-		err         error
-	)
-
-	args.RegisterUsage(flag, desc)
-
-	value, cleanedArgs, srcErr, err = setting(
-		flag, env, defaultStandIn, args.Args(),
-	)
-
-	if err == nil { //nolint:nestif // Ok.
-		if value == defaultStandIn {
-			result = def
-		} else {
-			if errors.Is(srcErr, ErrInvalidEnv) {
-				parseName = env
-			} else {
-				parseName = flag
-			}
-
-			result, err = parseUint(parseName, value)
-		}
-	}
-
-	args.args = cleanedArgs
-
-	if err != nil {
-		args.PushErr(srcErr)
-		args.PushErr(err)
-	}
-
-	return result
+	return Setting(args, ParseUint, flag, env, def, desc)
 }
 
 // SettingOption returns a configuration value based on a default,
@@ -690,21 +257,31 @@ func (args *Args) SettingUint(
 // Returns the final selected value.
 func (args *Args) SettingOption(
 	flag, env string, def string, validOptions []string, desc string,
+) string {
+	return args.SettingOptionMatch(
+		flag, env, def, validOptions, OptionExact, desc,
+	)
+}
+
+// SettingOptionMatch is SettingOption matching the validOptions as selected
+// by the match modes (IE: OptionIgnoreCase | OptionPrefix).  The canonical
+// option matched is returned.
+func (args *Args) SettingOptionMatch(
+	flag, env string, def string, validOptions []string,
+	match OptionMatch, desc string,
 ) string {
 	var (
-		value       string
-		cleanedArgs []string
-		result      string
-		parseName   string
-		srcErr      error
-		err         error
+		value     string
+		from      origin
+		result    string
+		parseName string
+		srcErr    error
+		err       error
 	)
 
 	args.RegisterUsage(flag, desc)
 
-	value, cleanedArgs, srcErr, err = setting(
-		flag, env, defaultStandIn, args.Args(),
-	)
+	value, from, srcErr, err = args.scanSetting(flag, env, defaultStandIn)
 
 	if err == nil { //nolint:nestif // Ok.
 		if value == defaultStandIn {
@@ -718,11 +295,10 @@ func (args *Args) SettingOption(
 			}
 		}
 
-		result, err = parseOption(parseName, value, validOptions)
+		result, err = parseOption(parseName, value, validOptions, match)
+		err = args.noteOrigin(err, from)
 	}
 
-	args.args = cleanedArgs
-
 	if err != nil {
 		args.PushErr(srcErr)
 		args.PushErr(err)
@@ -741,20 +317,25 @@ func (args *Args) SettingOption(
 // considered false.
 //
 // The command-line flag override takes no value—its presence alone indicates
-// true.
+// true. If the flag is negatable (IE: "[--[no-]color]") then its negated form
+// ("--no-color") overrides the environment variable setting the result to
+// false. The last form seen on the command line wins.
 //
 // Returns the resulting boolean value.
 func (args *Args) SettingIs(flag, env string, desc string) bool {
 	var (
 		value  string
 		result bool
+		found  bool
+		err    error
 	)
 
 	args.RegisterUsage(flag, desc)
 
-	result = args.Is(flag, desc)
+	result, found, err = args.scanPolarity(flag)
+	args.PushErr(err)
 
-	if !args.HasErr() && !result && env != "" {
+	if !args.HasErr() && !found && env != "" {
 		envValue, ok := os.LookupEnv(env)
 		if ok {
 			value = strings.ToLower(envValue)
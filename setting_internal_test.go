@@ -34,7 +34,9 @@ func TestSzargs_ValueNoArgNoEnv(t *testing.T) {
 	chk := sztestlog.CaptureNothing(t)
 	defer chk.Release()
 
-	value, args, srcErr, err := setting(tstArgFlag, tstEnv, "def", nil)
+	value, args, srcErr, err := setting(
+		tstArgFlag, tstEnv, "def", nil, nil, RepeatError,
+	)
 
 	chk.Str(value, "def")
 	chk.StrSlice(args, nil)
@@ -43,6 +45,8 @@ func TestSzargs_ValueNoArgNoEnv(t *testing.T) {
 
 	value, args, srcErr, err = setting(tstArgFlag, tstEnv, "def",
 		[]string{"arg1", "arg2"},
+		nil,
+		RepeatError,
 	)
 
 	chk.Str(value, "def")
@@ -59,6 +63,8 @@ func TestSzargs_ValueArgAmbiguous(t *testing.T) {
 
 	value, args, srcErr, err := setting(tstArgFlag, tstEnv, "def",
 		[]string{tstArg, "first", "arg1", "arg2", tstArg, "second"},
+		nil,
+		RepeatError,
 	)
 
 	chk.Str(value, "")
@@ -79,6 +85,8 @@ func TestSzargs_ValueArgMissing(t *testing.T) {
 
 	value, args, srcErr, err := setting(tstArgFlag, tstEnv, "def",
 		[]string{"arg1", "arg2", tstArg},
+		nil,
+		RepeatError,
 	)
 
 	chk.Str(value, "")
@@ -101,6 +109,8 @@ func TestSzargs_ValueEnv(t *testing.T) {
 
 	value, args, srcErr, err := setting(tstArgFlag, tstEnv, "def",
 		[]string{"arg1", "arg2"},
+		nil,
+		RepeatError,
 	)
 
 	chk.Str(value, "env")
@@ -119,6 +129,8 @@ func TestSzargs_ValueEnvAndArg(t *testing.T) {
 
 	value, args, srcErr, err := setting(tstArgFlag, tstEnv, "def",
 		[]string{"arg1", tstArg, "arg", "arg2"},
+		nil,
+		RepeatError,
 	)
 
 	chk.Str(value, "arg")
@@ -138,6 +138,47 @@ func TestSzargs_SettingString_Arg(t *testing.T) {
 	)
 }
 
+func TestSzargs_SettingString_ArgAttached(t *testing.T) {
+	chk := sztestlog.CaptureNothing(t)
+	defer chk.Release()
+
+	args := szargs.New("program description", []string{
+		"programName",
+		"--test=testValue1",
+		"anotherArg1",
+	})
+
+	chk.SetEnv(tstEnv, "envValue")
+
+	result := args.SettingString(
+		"[-t | --test value]", tstEnv, "def", "testName",
+	)
+
+	chk.NoErr(args.Err())
+	chk.Str(result, "testValue1")
+	chk.StrSlice(args.Args(), []string{"anotherArg1"})
+}
+
+func TestSzargs_SettingInt_ArgAttached(t *testing.T) {
+	chk := sztestlog.CaptureNothing(t)
+	defer chk.Release()
+
+	args := szargs.New("program description", []string{
+		"programName",
+		"-t444",
+	})
+
+	chk.SetEnv(tstEnv, "333")
+
+	result := args.SettingInt(
+		tstArgFlag, tstEnv, 222, "testName",
+	)
+
+	chk.NoErr(args.Err())
+	chk.Int(result, 444)
+	chk.StrSlice(args.Args(), nil)
+}
+
 /*
  ***************************************************************************
  *
@@ -1623,3 +1664,78 @@ func TestSzargs_SettingIs_Success(t *testing.T) {
 	chk.True(result)
 	chk.StrSlice(args.Args(), nil)
 }
+
+func TestSzargs_SettingIs_Negated(t *testing.T) {
+	chk := sztestlog.CaptureNothing(t)
+	defer chk.Release()
+
+	chk.SetEnv(tstEnv, "1")
+
+	args := szargs.New("program description", []string{
+		"programName",
+		"--no-test",
+	})
+
+	result := args.SettingIs(
+		"[-t | --[no-]test]", tstEnv, "testName",
+	)
+
+	chk.NoErr(args.Err())
+	chk.False(result)
+	chk.StrSlice(args.Args(), nil)
+
+	chk.SetEnv(tstEnv, "0")
+
+	args = szargs.New("program description", []string{
+		"programName",
+		"--no-test",
+		"-t",
+		"--no-test",
+		"--test",
+	})
+
+	result = args.SettingIs(
+		"[-t | --[no-]test]", tstEnv, "testName",
+	)
+
+	chk.NoErr(args.Err())
+	chk.True(result)
+	chk.StrSlice(args.Args(), nil)
+}
+
+func TestSzargs_SettingString_ErrorThenPositional(t *testing.T) {
+	chk := sztestlog.CaptureNothing(t)
+	defer chk.Release()
+
+	args := szargs.New("program description", []string{
+		"programName",
+		"-n", "a",
+		"-n", "b",
+		"pos1",
+		"pos2",
+		"pos3",
+	})
+
+	result := args.SettingString("[-n x]", "", "def", "testName")
+
+	chk.Str(result, "")
+	chk.StrSlice(
+		args.Args(), []string{"-n", "a", "-n", "b", "pos1", "pos2", "pos3"},
+	)
+
+	chk.Str(args.LastString("last", "the last"), "pos3")
+	chk.Str(args.NextString("next", "the next"), "-n")
+	chk.StrSlice(
+		args.RestString("rest", 0, -1, "the rest"),
+		[]string{"a", "-n", "b", "pos1", "pos2"},
+	)
+
+	chk.Err(
+		args.Err(),
+		chk.ErrChain(
+			szargs.ErrInvalidFlag,
+			szargs.ErrAmbiguous,
+			"'[-n x]' for 'b' already set to: 'a'",
+		),
+	)
+}
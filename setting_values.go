@@ -0,0 +1,309 @@
+/*
+   Szerszam argument library: szargs.
+   Copyright (C) 2024-2025  Leslie Dancsecs
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU General Public License for more details.
+
+   You should have received a copy of the GNU General Public License
+   along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package szargs
+
+import "os"
+
+// settingValues selects a list of values from repeated (and/or delimited)
+// instances of a flagged argument falling back to a (delimited) environment
+// variable.  The returned boolean is false if neither source provided any
+// values indicating the default should be used.  The source error identifies
+// the source of the values (ErrInvalidFlag or ErrInvalidEnv).
+func (args *Args) settingValues(
+	flag, env string,
+) ([]string, []origin, bool, error, error) {
+	values, origins, err := args.scanValues(flag)
+	if err != nil {
+		return nil, nil, false, ErrInvalidFlag, err
+	}
+
+	if len(values) > 0 {
+		return values, origins, true, ErrInvalidFlag, nil
+	}
+
+	if env != "" {
+		envValue, ok := os.LookupEnv(env)
+		if ok {
+			values, origins, err = args.splitValues(
+				env, []string{envValue}, make([]origin, 1),
+			)
+			if envValue == "" {
+				values, origins = nil, nil
+			}
+
+			return values, origins, true, ErrInvalidEnv, err
+		}
+	}
+
+	return nil, nil, false, ErrInvalidDefault, nil
+}
+
+// SettingValuesString returns a list of configuration values based on a
+// default, optionally overridden by an environment variable, and further
+// overridden by repeated instances of a flagged command-line argument. The
+// environment variable and flagged values are split using the list separator
+// (if one has been set).
+//
+// Returns the final selected string values.
+func (args *Args) SettingValuesString(
+	flag, env string, def []string, desc string,
+) []string {
+	args.RegisterUsage(flag, desc)
+
+	values, _, found, srcErr, err := args.settingValues(flag, env)
+	if err != nil {
+		args.PushErr(srcErr)
+		args.PushErr(err)
+
+		return nil
+	}
+
+	if !found {
+		return def
+	}
+
+	return values
+}
+
+// SettingValuesFloat64 returns a list of configuration values based on a
+// default, optionally overridden by an environment variable, and further
+// overridden by repeated instances of a flagged command-line argument. The
+// environment variable and flagged values are split using the list separator
+// (if one has been set) and each value is parsed as a 64 bit floating point
+// number.
+//
+// If any final value has invalid syntax or is out of range for a float64, an
+// error is registered.
+//
+// Returns the final parsed float64 values.
+func (args *Args) SettingValuesFloat64(
+	flag, env string, def []float64, desc string,
+) []float64 {
+	return SettingValues(args, ParseFloat64, flag, env, def, desc)
+}
+
+// SettingValuesFloat32 returns a list of configuration values based on a
+// default, optionally overridden by an environment variable, and further
+// overridden by repeated instances of a flagged command-line argument. The
+// environment variable and flagged values are split using the list separator
+// (if one has been set) and each value is parsed as a 32 bit floating point
+// number.
+//
+// If any final value has invalid syntax or is out of range for a float32, an
+// error is registered.
+//
+// Returns the final parsed float32 values.
+func (args *Args) SettingValuesFloat32(
+	flag, env string, def []float32, desc string,
+) []float32 {
+	return SettingValues(args, ParseFloat32, flag, env, def, desc)
+}
+
+// SettingValuesInt64 returns a list of configuration values based on a
+// default, optionally overridden by an environment variable, and further
+// overridden by repeated instances of a flagged command-line argument. The
+// environment variable and flagged values are split using the list separator
+// (if one has been set) and each value is parsed as a signed 64 bit integer.
+//
+// If any final value has invalid syntax or is out of range for an int64, an
+// error is registered.
+//
+// Returns the final parsed int64 values.
+func (args *Args) SettingValuesInt64(
+	flag, env string, def []int64, desc string,
+) []int64 {
+	return SettingValues(args, ParseInt64, flag, env, def, desc)
+}
+
+// SettingValuesInt32 returns a list of configuration values based on a
+// default, optionally overridden by an environment variable, and further
+// overridden by repeated instances of a flagged command-line argument. The
+// environment variable and flagged values are split using the list separator
+// (if one has been set) and each value is parsed as a signed 32 bit integer.
+//
+// If any final value has invalid syntax or is out of range for an int32, an
+// error is registered.
+//
+// Returns the final parsed int32 values.
+func (args *Args) SettingValuesInt32(
+	flag, env string, def []int32, desc string,
+) []int32 {
+	return SettingValues(args, ParseInt32, flag, env, def, desc)
+}
+
+// SettingValuesInt16 returns a list of configuration values based on a
+// default, optionally overridden by an environment variable, and further
+// overridden by repeated instances of a flagged command-line argument. The
+// environment variable and flagged values are split using the list separator
+// (if one has been set) and each value is parsed as a signed 16 bit integer.
+//
+// If any final value has invalid syntax or is out of range for an int16, an
+// error is registered.
+//
+// Returns the final parsed int16 values.
+func (args *Args) SettingValuesInt16(
+	flag, env string, def []int16, desc string,
+) []int16 {
+	return SettingValues(args, ParseInt16, flag, env, def, desc)
+}
+
+// SettingValuesInt8 returns a list of configuration values based on a default,
+// optionally overridden by an environment variable, and further overridden by
+// repeated instances of a flagged command-line argument. The environment
+// variable and flagged values are split using the list separator (if one has
+// been set) and each value is parsed as a signed 8 bit integer.
+//
+// If any final value has invalid syntax or is out of range for an int8, an
+// error is registered.
+//
+// Returns the final parsed int8 values.
+func (args *Args) SettingValuesInt8(
+	flag, env string, def []int8, desc string,
+) []int8 {
+	return SettingValues(args, ParseInt8, flag, env, def, desc)
+}
+
+// SettingValuesInt returns a list of configuration values based on a default,
+// optionally overridden by an environment variable, and further overridden by
+// repeated instances of a flagged command-line argument. The environment
+// variable and flagged values are split using the list separator (if one has
+// been set) and each value is parsed as a signed integer.
+//
+// If any final value has invalid syntax or is out of range for an int, an
+// error is registered.
+//
+// Returns the final parsed int values.
+func (args *Args) SettingValuesInt(
+	flag, env string, def []int, desc string,
+) []int {
+	return SettingValues(args, ParseInt, flag, env, def, desc)
+}
+
+// SettingValuesUint64 returns a list of configuration values based on a
+// default, optionally overridden by an environment variable, and further
+// overridden by repeated instances of a flagged command-line argument. The
+// environment variable and flagged values are split using the list separator
+// (if one has been set) and each value is parsed as an unsigned 64 bit
+// integer.
+//
+// If any final value has invalid syntax or is out of range for a uint64, an
+// error is registered.
+//
+// Returns the final parsed uint64 values.
+func (args *Args) SettingValuesUint64(
+	flag, env string, def []uint64, desc string,
+) []uint64 {
+	return SettingValues(args, ParseUint64, flag, env, def, desc)
+}
+
+// SettingValuesUint32 returns a list of configuration values based on a
+// default, optionally overridden by an environment variable, and further
+// overridden by repeated instances of a flagged command-line argument. The
+// environment variable and flagged values are split using the list separator
+// (if one has been set) and each value is parsed as an unsigned 32 bit
+// integer.
+//
+// If any final value has invalid syntax or is out of range for a uint32, an
+// error is registered.
+//
+// Returns the final parsed uint32 values.
+func (args *Args) SettingValuesUint32(
+	flag, env string, def []uint32, desc string,
+) []uint32 {
+	return SettingValues(args, ParseUint32, flag, env, def, desc)
+}
+
+// SettingValuesUint16 returns a list of configuration values based on a
+// default, optionally overridden by an environment variable, and further
+// overridden by repeated instances of a flagged command-line argument. The
+// environment variable and flagged values are split using the list separator
+// (if one has been set) and each value is parsed as an unsigned 16 bit
+// integer.
+//
+// If any final value has invalid syntax or is out of range for a uint16, an
+// error is registered.
+//
+// Returns the final parsed uint16 values.
+func (args *Args) SettingValuesUint16(
+	flag, env string, def []uint16, desc string,
+) []uint16 {
+	return SettingValues(args, ParseUint16, flag, env, def, desc)
+}
+
+// SettingValuesUint8 returns a list of configuration values based on a
+// default, optionally overridden by an environment variable, and further
+// overridden by repeated instances of a flagged command-line argument. The
+// environment variable and flagged values are split using the list separator
+// (if one has been set) and each value is parsed as an unsigned 8 bit integer.
+//
+// If any final value has invalid syntax or is out of range for a uint8, an
+// error is registered.
+//
+// Returns the final parsed uint8 values.
+func (args *Args) SettingValuesUint8(
+	flag, env string, def []uint8, desc string,
+) []uint8 {
+	return SettingValues(args, ParseUint8, flag, env, def, desc)
+}
+
+// SettingValuesUint returns a list of configuration values based on a default,
+// optionally overridden by an environment variable, and further overridden by
+// repeated instances of a flagged command-line argument. The environment
+// variable and flagged values are split using the list separator (if one has
+// been set) and each value is parsed as an unsigned integer.
+//
+// If any final value has invalid syntax or is out of range for a uint, an
+// error is registered.
+//
+// Returns the final parsed uint values.
+func (args *Args) SettingValuesUint(
+	flag, env string, def []uint, desc string,
+) []uint {
+	return SettingValues(args, ParseUint, flag, env, def, desc)
+}
+
+// SettingValuesOption returns a list of configuration values based on a
+// default, optionally overridden by an environment variable, and further
+// overridden by repeated instances of a flagged command-line argument. The
+// environment variable and flagged values are split using the list separator
+// (if one has been set).
+//
+// If any final value is not found in the list of validOptions, an error is
+// registered.
+//
+// Returns the final selected values.
+func (args *Args) SettingValuesOption(
+	flag, env string, def []string, validOptions []string, desc string,
+) []string {
+	return args.SettingValuesOptionMatch(
+		flag, env, def, validOptions, OptionExact, desc,
+	)
+}
+
+// SettingValuesOptionMatch is SettingValuesOption matching the validOptions as
+// selected by the match modes (IE: OptionIgnoreCase | OptionPrefix).  The
+// canonical option matched is returned.
+func (args *Args) SettingValuesOptionMatch(
+	flag, env string, def []string, validOptions []string,
+	match OptionMatch, desc string,
+) []string {
+	return SettingValues(
+		args, OptionParser(validOptions, match), flag, env, def, desc,
+	)
+}
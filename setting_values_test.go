@@ -0,0 +1,656 @@
+/*
+   Szerszam argument library: szargs.
+   Copyright (C) 2024-2025  Leslie Dancsecs
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU General Public License for more details.
+
+   You should have received a copy of the GNU General Public License
+   along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package szargs_test
+
+import (
+	"testing"
+
+	"github.com/dancsecs/szargs"
+	"github.com/dancsecs/sztestlog"
+)
+
+const tstArgValuesFlag = "[-t value ...]"
+
+func TestSzargs_SettingValuesString(t *testing.T) {
+	chk := sztestlog.CaptureNothing(t)
+	defer chk.Release()
+
+	def := []string{"d1", "d2"}
+
+	args := szargs.New("program description", []string{
+		"programName",
+	})
+
+	args.SetListSeparator(",")
+
+	// Default.
+	chk.StrSlice(
+		args.SettingValuesString(tstArgValuesFlag, tstEnv, def, "hosts"),
+		def,
+	)
+	chk.NoErr(args.Err())
+
+	// Environment.
+	chk.SetEnv(tstEnv, "e1,e2,e3")
+	chk.StrSlice(
+		args.SettingValuesString(tstArgValuesFlag, tstEnv, def, "hosts"),
+		[]string{"e1", "e2", "e3"},
+	)
+	chk.NoErr(args.Err())
+
+	// Empty environment.
+	chk.SetEnv(tstEnv, "")
+	chk.StrSlice(
+		args.SettingValuesString(tstArgValuesFlag, tstEnv, def, "hosts"),
+		nil,
+	)
+	chk.NoErr(args.Err())
+
+	// Arguments.
+	args = szargs.New("program description", []string{
+		"programName",
+		"-t", "a1,a2",
+		"-t", "a3",
+	})
+
+	args.SetListSeparator(",")
+
+	chk.StrSlice(
+		args.SettingValuesString(tstArgValuesFlag, tstEnv, def, "hosts"),
+		[]string{"a1", "a2", "a3"},
+	)
+	chk.NoErr(args.Err())
+	chk.StrSlice(args.Args(), nil)
+}
+
+func TestSzargs_SettingValuesString_NoSeparator(t *testing.T) {
+	chk := sztestlog.CaptureNothing(t)
+	defer chk.Release()
+
+	args := szargs.New("program description", []string{
+		"programName",
+	})
+
+	chk.SetEnv(tstEnv, "e1,e2")
+	chk.StrSlice(
+		args.SettingValuesString(tstArgValuesFlag, tstEnv, nil, "hosts"),
+		[]string{"e1,e2"},
+	)
+	chk.NoErr(args.Err())
+}
+
+func TestSzargs_SettingValuesString_Missing(t *testing.T) {
+	chk := sztestlog.CaptureNothing(t)
+	defer chk.Release()
+
+	args := szargs.New("program description", []string{
+		"programName",
+		"-t",
+	})
+
+	chk.StrSlice(
+		args.SettingValuesString(tstArgValuesFlag, tstEnv, nil, "hosts"),
+		nil,
+	)
+	chk.Err(
+		args.Err(),
+		chk.ErrChain(
+			szargs.ErrInvalidFlag,
+			szargs.ErrMissing,
+			"'"+tstArgValuesFlag+"'",
+		),
+	)
+}
+
+func TestSzargs_SettingValuesInt(t *testing.T) {
+	chk := sztestlog.CaptureNothing(t)
+	defer chk.Release()
+
+	args := szargs.New("program description", []string{
+		"programName",
+	})
+
+	args.SetListSeparator(",")
+
+	chk.IntSlice(
+		args.SettingValuesInt(tstArgValuesFlag, tstEnv, []int{1}, "nums"),
+		[]int{1},
+	)
+	chk.NoErr(args.Err())
+
+	chk.SetEnv(tstEnv, "2,3")
+	chk.IntSlice(
+		args.SettingValuesInt(tstArgValuesFlag, tstEnv, []int{1}, "nums"),
+		[]int{2, 3},
+	)
+	chk.NoErr(args.Err())
+
+	args = szargs.New("program description", []string{
+		"programName",
+		"-t", "4,5",
+	})
+
+	args.SetListSeparator(",")
+
+	chk.IntSlice(
+		args.SettingValuesInt(tstArgValuesFlag, tstEnv, []int{1}, "nums"),
+		[]int{4, 5},
+	)
+	chk.NoErr(args.Err())
+}
+
+func TestSzargs_SettingValuesInt_Invalid_Env(t *testing.T) {
+	chk := sztestlog.CaptureNothing(t)
+	defer chk.Release()
+
+	args := szargs.New("program description", []string{
+		"programName",
+	})
+
+	args.SetListSeparator(",")
+
+	chk.SetEnv(tstEnv, "2,three")
+	chk.IntSlice(
+		args.SettingValuesInt(tstArgValuesFlag, tstEnv, []int{1}, "nums"),
+		nil,
+	)
+	chk.Err(
+		args.Err(),
+		chk.ErrChain(
+			szargs.ErrInvalidEnv,
+			szargs.ErrInvalidInt,
+			szargs.ErrSyntax,
+			tstEnv,
+			"'three'",
+		),
+	)
+}
+
+func TestSzargs_SettingValuesInt_Invalid_Arg(t *testing.T) {
+	chk := sztestlog.CaptureNothing(t)
+	defer chk.Release()
+
+	args := szargs.New("program description", []string{
+		"programName",
+		"-t", "x,2",
+	})
+
+	args.SetListSeparator(",")
+
+	chk.SetEnv(tstEnv, "2,3")
+	chk.IntSlice(
+		args.SettingValuesInt(tstArgValuesFlag, tstEnv, []int{1}, "nums"),
+		nil,
+	)
+	chk.Err(
+		args.Err(),
+		chk.ErrChain(
+			szargs.ErrInvalidFlag,
+			szargs.ErrInvalidInt,
+			szargs.ErrSyntax,
+			tstArgValuesFlag,
+			"'x'",
+		),
+	)
+}
+
+func TestSzargs_SettingValuesOption(t *testing.T) {
+	chk := sztestlog.CaptureNothing(t)
+	defer chk.Release()
+
+	validOptions := []string{"red", "green", "blue"}
+
+	args := szargs.New("program description", []string{
+		"programName",
+		"-t", "red,blue",
+	})
+
+	args.SetListSeparator(",")
+
+	chk.StrSlice(
+		args.SettingValuesOption(
+			tstArgValuesFlag, tstEnv, nil, validOptions, "colors",
+		),
+		[]string{"red", "blue"},
+	)
+	chk.NoErr(args.Err())
+
+	chk.SetEnv(tstEnv, "red,pink")
+	chk.StrSlice(
+		args.SettingValuesOption(
+			tstArgValuesFlag, tstEnv, nil, validOptions, "colors",
+		),
+		nil,
+	)
+	chk.Err(
+		args.Err(),
+		chk.ErrChain(
+			szargs.ErrInvalidEnv,
+			szargs.ErrInvalidOption,
+			"'pink' ("+tstEnv+" must be one of [red green blue])",
+		),
+	)
+}
+
+func TestSzargs_SettingValuesFloat64(t *testing.T) {
+	chk := sztestlog.CaptureNothing(t)
+	defer chk.Release()
+
+	args := szargs.New("program description", []string{
+		"programName",
+		"-t", "4,5",
+	})
+
+	args.SetListSeparator(",")
+
+	result := args.SettingValuesFloat64(
+		tstArgValuesFlag, tstEnv, []float64{1}, "nums",
+	)
+
+	chk.NoErr(args.Err())
+	chk.Float64Slice(result, []float64{4, 5}, 0)
+
+	chk.SetEnv(tstEnv, "2,three")
+
+	result = args.SettingValuesFloat64(
+		tstArgValuesFlag, tstEnv, []float64{1}, "nums",
+	)
+
+	chk.Err(
+		args.Err(),
+		chk.ErrChain(
+			szargs.ErrInvalidEnv,
+			szargs.ErrInvalidFloat64,
+			szargs.ErrSyntax,
+			tstEnv,
+			"'three'",
+		),
+	)
+	chk.Float64Slice(result, nil, 0)
+}
+
+func TestSzargs_SettingValuesFloat32(t *testing.T) {
+	chk := sztestlog.CaptureNothing(t)
+	defer chk.Release()
+
+	args := szargs.New("program description", []string{
+		"programName",
+		"-t", "4,5",
+	})
+
+	args.SetListSeparator(",")
+
+	result := args.SettingValuesFloat32(
+		tstArgValuesFlag, tstEnv, []float32{1}, "nums",
+	)
+
+	chk.NoErr(args.Err())
+	chk.Float32Slice(result, []float32{4, 5}, 0)
+
+	chk.SetEnv(tstEnv, "2,three")
+
+	result = args.SettingValuesFloat32(
+		tstArgValuesFlag, tstEnv, []float32{1}, "nums",
+	)
+
+	chk.Err(
+		args.Err(),
+		chk.ErrChain(
+			szargs.ErrInvalidEnv,
+			szargs.ErrInvalidFloat32,
+			szargs.ErrSyntax,
+			tstEnv,
+			"'three'",
+		),
+	)
+	chk.Float32Slice(result, nil, 0)
+}
+
+func TestSzargs_SettingValuesInt64(t *testing.T) {
+	chk := sztestlog.CaptureNothing(t)
+	defer chk.Release()
+
+	args := szargs.New("program description", []string{
+		"programName",
+		"-t", "4,5",
+	})
+
+	args.SetListSeparator(",")
+
+	result := args.SettingValuesInt64(
+		tstArgValuesFlag, tstEnv, []int64{1}, "nums",
+	)
+
+	chk.NoErr(args.Err())
+	chk.Int64Slice(result, []int64{4, 5})
+
+	chk.SetEnv(tstEnv, "2,three")
+
+	result = args.SettingValuesInt64(
+		tstArgValuesFlag, tstEnv, []int64{1}, "nums",
+	)
+
+	chk.Err(
+		args.Err(),
+		chk.ErrChain(
+			szargs.ErrInvalidEnv,
+			szargs.ErrInvalidInt64,
+			szargs.ErrSyntax,
+			tstEnv,
+			"'three'",
+		),
+	)
+	chk.Int64Slice(result, nil)
+}
+
+func TestSzargs_SettingValuesInt32(t *testing.T) {
+	chk := sztestlog.CaptureNothing(t)
+	defer chk.Release()
+
+	args := szargs.New("program description", []string{
+		"programName",
+		"-t", "4,5",
+	})
+
+	args.SetListSeparator(",")
+
+	result := args.SettingValuesInt32(
+		tstArgValuesFlag, tstEnv, []int32{1}, "nums",
+	)
+
+	chk.NoErr(args.Err())
+	chk.Int32Slice(result, []int32{4, 5})
+
+	chk.SetEnv(tstEnv, "2,three")
+
+	result = args.SettingValuesInt32(
+		tstArgValuesFlag, tstEnv, []int32{1}, "nums",
+	)
+
+	chk.Err(
+		args.Err(),
+		chk.ErrChain(
+			szargs.ErrInvalidEnv,
+			szargs.ErrInvalidInt32,
+			szargs.ErrSyntax,
+			tstEnv,
+			"'three'",
+		),
+	)
+	chk.Int32Slice(result, nil)
+}
+
+func TestSzargs_SettingValuesInt16(t *testing.T) {
+	chk := sztestlog.CaptureNothing(t)
+	defer chk.Release()
+
+	args := szargs.New("program description", []string{
+		"programName",
+		"-t", "4,5",
+	})
+
+	args.SetListSeparator(",")
+
+	result := args.SettingValuesInt16(
+		tstArgValuesFlag, tstEnv, []int16{1}, "nums",
+	)
+
+	chk.NoErr(args.Err())
+	chk.Int16Slice(result, []int16{4, 5})
+
+	chk.SetEnv(tstEnv, "2,three")
+
+	result = args.SettingValuesInt16(
+		tstArgValuesFlag, tstEnv, []int16{1}, "nums",
+	)
+
+	chk.Err(
+		args.Err(),
+		chk.ErrChain(
+			szargs.ErrInvalidEnv,
+			szargs.ErrInvalidInt16,
+			szargs.ErrSyntax,
+			tstEnv,
+			"'three'",
+		),
+	)
+	chk.Int16Slice(result, nil)
+}
+
+func TestSzargs_SettingValuesInt8(t *testing.T) {
+	chk := sztestlog.CaptureNothing(t)
+	defer chk.Release()
+
+	args := szargs.New("program description", []string{
+		"programName",
+		"-t", "4,5",
+	})
+
+	args.SetListSeparator(",")
+
+	result := args.SettingValuesInt8(
+		tstArgValuesFlag, tstEnv, []int8{1}, "nums",
+	)
+
+	chk.NoErr(args.Err())
+	chk.Int8Slice(result, []int8{4, 5})
+
+	chk.SetEnv(tstEnv, "2,three")
+
+	result = args.SettingValuesInt8(
+		tstArgValuesFlag, tstEnv, []int8{1}, "nums",
+	)
+
+	chk.Err(
+		args.Err(),
+		chk.ErrChain(
+			szargs.ErrInvalidEnv,
+			szargs.ErrInvalidInt8,
+			szargs.ErrSyntax,
+			tstEnv,
+			"'three'",
+		),
+	)
+	chk.Int8Slice(result, nil)
+}
+
+func TestSzargs_SettingValuesUint64(t *testing.T) {
+	chk := sztestlog.CaptureNothing(t)
+	defer chk.Release()
+
+	args := szargs.New("program description", []string{
+		"programName",
+		"-t", "4,5",
+	})
+
+	args.SetListSeparator(",")
+
+	result := args.SettingValuesUint64(
+		tstArgValuesFlag, tstEnv, []uint64{1}, "nums",
+	)
+
+	chk.NoErr(args.Err())
+	chk.Uint64Slice(result, []uint64{4, 5})
+
+	chk.SetEnv(tstEnv, "2,three")
+
+	result = args.SettingValuesUint64(
+		tstArgValuesFlag, tstEnv, []uint64{1}, "nums",
+	)
+
+	chk.Err(
+		args.Err(),
+		chk.ErrChain(
+			szargs.ErrInvalidEnv,
+			szargs.ErrInvalidUint64,
+			szargs.ErrSyntax,
+			tstEnv,
+			"'three'",
+		),
+	)
+	chk.Uint64Slice(result, nil)
+}
+
+func TestSzargs_SettingValuesUint32(t *testing.T) {
+	chk := sztestlog.CaptureNothing(t)
+	defer chk.Release()
+
+	args := szargs.New("program description", []string{
+		"programName",
+		"-t", "4,5",
+	})
+
+	args.SetListSeparator(",")
+
+	result := args.SettingValuesUint32(
+		tstArgValuesFlag, tstEnv, []uint32{1}, "nums",
+	)
+
+	chk.NoErr(args.Err())
+	chk.Uint32Slice(result, []uint32{4, 5})
+
+	chk.SetEnv(tstEnv, "2,three")
+
+	result = args.SettingValuesUint32(
+		tstArgValuesFlag, tstEnv, []uint32{1}, "nums",
+	)
+
+	chk.Err(
+		args.Err(),
+		chk.ErrChain(
+			szargs.ErrInvalidEnv,
+			szargs.ErrInvalidUint32,
+			szargs.ErrSyntax,
+			tstEnv,
+			"'three'",
+		),
+	)
+	chk.Uint32Slice(result, nil)
+}
+
+func TestSzargs_SettingValuesUint16(t *testing.T) {
+	chk := sztestlog.CaptureNothing(t)
+	defer chk.Release()
+
+	args := szargs.New("program description", []string{
+		"programName",
+		"-t", "4,5",
+	})
+
+	args.SetListSeparator(",")
+
+	result := args.SettingValuesUint16(
+		tstArgValuesFlag, tstEnv, []uint16{1}, "nums",
+	)
+
+	chk.NoErr(args.Err())
+	chk.Uint16Slice(result, []uint16{4, 5})
+
+	chk.SetEnv(tstEnv, "2,three")
+
+	result = args.SettingValuesUint16(
+		tstArgValuesFlag, tstEnv, []uint16{1}, "nums",
+	)
+
+	chk.Err(
+		args.Err(),
+		chk.ErrChain(
+			szargs.ErrInvalidEnv,
+			szargs.ErrInvalidUint16,
+			szargs.ErrSyntax,
+			tstEnv,
+			"'three'",
+		),
+	)
+	chk.Uint16Slice(result, nil)
+}
+
+func TestSzargs_SettingValuesUint8(t *testing.T) {
+	chk := sztestlog.CaptureNothing(t)
+	defer chk.Release()
+
+	args := szargs.New("program description", []string{
+		"programName",
+		"-t", "4,5",
+	})
+
+	args.SetListSeparator(",")
+
+	result := args.SettingValuesUint8(
+		tstArgValuesFlag, tstEnv, []uint8{1}, "nums",
+	)
+
+	chk.NoErr(args.Err())
+	chk.Uint8Slice(result, []uint8{4, 5})
+
+	chk.SetEnv(tstEnv, "2,three")
+
+	result = args.SettingValuesUint8(
+		tstArgValuesFlag, tstEnv, []uint8{1}, "nums",
+	)
+
+	chk.Err(
+		args.Err(),
+		chk.ErrChain(
+			szargs.ErrInvalidEnv,
+			szargs.ErrInvalidUint8,
+			szargs.ErrSyntax,
+			tstEnv,
+			"'three'",
+		),
+	)
+	chk.Uint8Slice(result, nil)
+}
+
+func TestSzargs_SettingValuesUint(t *testing.T) {
+	chk := sztestlog.CaptureNothing(t)
+	defer chk.Release()
+
+	args := szargs.New("program description", []string{
+		"programName",
+		"-t", "4,5",
+	})
+
+	args.SetListSeparator(",")
+
+	result := args.SettingValuesUint(
+		tstArgValuesFlag, tstEnv, []uint{1}, "nums",
+	)
+
+	chk.NoErr(args.Err())
+	chk.UintSlice(result, []uint{4, 5})
+
+	chk.SetEnv(tstEnv, "2,three")
+
+	result = args.SettingValuesUint(
+		tstArgValuesFlag, tstEnv, []uint{1}, "nums",
+	)
+
+	chk.Err(
+		args.Err(),
+		chk.ErrChain(
+			szargs.ErrInvalidEnv,
+			szargs.ErrInvalidUint,
+			szargs.ErrSyntax,
+			tstEnv,
+			"'three'",
+		),
+	)
+	chk.UintSlice(result, nil)
+}
@@ -0,0 +1,136 @@
+/*
+   Szerszam argument library: szargs.
+   Copyright (C) 2024-2025  Leslie Dancsecs
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU General Public License for more details.
+
+   You should have received a copy of the GNU General Public License
+   along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package szargs
+
+import (
+	"fmt"
+	"strings"
+)
+
+// shellWord is a single word split from a text along with the line on which
+// it started.
+type shellWord struct {
+	text string
+	line int
+}
+
+// splitShell splits the text into words using shell-like quoting rules.
+// Words are separated by unquoted white space.  Single quotes preserve every
+// enclosed character, double quotes preserve every enclosed character except
+// a backslash escaping a double quote or a backslash and a backslash outside
+// of quotes escapes the following character.  An unquoted '#' starting a word
+// begins a comment running to the end of the line.  On error the line at which
+// the error was detected is returned.
+//
+//nolint:cyclop,funlen // Ok.
+func splitShell(str string) ([]shellWord, int, error) {
+	var (
+		words     []shellWord
+		word      strings.Builder
+		inWord    bool
+		quote     byte
+		line      = 1
+		wordLine  int
+		quoteLine int
+	)
+
+	startWord := func() {
+		if !inWord {
+			inWord = true
+			wordLine = line
+		}
+	}
+
+	endWord := func() {
+		if inWord {
+			words = append(
+				words, shellWord{text: word.String(), line: wordLine},
+			)
+			word.Reset()
+
+			inWord = false
+		}
+	}
+
+	for i := 0; i < len(str); i++ {
+		if i > 0 && str[i-1] == '\n' {
+			line++
+		}
+
+		chr := str[i]
+
+		switch {
+		case quote == '\'':
+			if chr == quote {
+				quote = 0
+			} else {
+				word.WriteByte(chr)
+			}
+		case quote == '"':
+			switch {
+			case chr == quote:
+				quote = 0
+			case chr == '\\' && i+1 < len(str) &&
+				(str[i+1] == '"' || str[i+1] == '\\'):
+				i++
+				word.WriteByte(str[i])
+			default:
+				word.WriteByte(chr)
+			}
+		case chr == '\\':
+			if i+1 == len(str) {
+				return nil, line, fmt.Errorf("%w: trailing escape", ErrSyntax)
+			}
+
+			i++
+
+			if str[i] != '\n' { // A line continuation otherwise.
+				startWord()
+				word.WriteByte(str[i])
+			}
+		case chr == '\'' || chr == '"':
+			startWord()
+
+			quote = chr
+			quoteLine = line
+		case chr == '#' && !inWord:
+			end := strings.IndexByte(str[i:], '\n')
+			if end < 0 {
+				i = len(str)
+			} else {
+				i += end - 1
+			}
+		case chr == ' ' || chr == '\t' || chr == '\n' || chr == '\r':
+			endWord()
+		default:
+			startWord()
+			word.WriteByte(chr)
+		}
+	}
+
+	if quote != 0 {
+		return nil, quoteLine, fmt.Errorf(
+			"%w: unterminated quote (%c)", ErrSyntax, quote,
+		)
+	}
+
+	endWord()
+
+	return words, 0, nil
+}
@@ -0,0 +1,85 @@
+/*
+   Szerszam argument library: szargs.
+   Copyright (C) 2024-2025  Leslie Dancsecs
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU General Public License for more details.
+
+   You should have received a copy of the GNU General Public License
+   along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package szargs
+
+import (
+	"testing"
+
+	"github.com/dancsecs/sztestlog"
+)
+
+func shellTexts(words []shellWord) ([]string, []int) {
+	var (
+		texts []string
+		lines []int
+	)
+
+	for _, word := range words {
+		texts = append(texts, word.text)
+		lines = append(lines, word.line)
+	}
+
+	return texts, lines
+}
+
+func TestSzargs_SplitShell(t *testing.T) {
+	chk := sztestlog.CaptureNothing(t)
+	defer chk.Release()
+
+	words, line, err := splitShell("")
+	chk.NoErr(err)
+	chk.Int(line, 0)
+	chk.Int(len(words), 0)
+
+	words, _, err = splitShell(
+		"-v --name 'a b' \"two words\"\n" +
+			"# A comment line.\n" +
+			"  plain\\ space \"q\\\"uote\" 'it''s' \"\" # trailing\n" +
+			"con\\\ntinued\n",
+	)
+	texts, lines := shellTexts(words)
+
+	chk.NoErr(err)
+	chk.StrSlice(texts, []string{
+		"-v", "--name", "a b", "two words",
+		"plain space", "q\"uote", "its", "",
+		"continued",
+	})
+	chk.IntSlice(lines, []int{1, 1, 1, 1, 3, 3, 3, 3, 4})
+}
+
+func TestSzargs_SplitShell_Errors(t *testing.T) {
+	chk := sztestlog.CaptureNothing(t)
+	defer chk.Release()
+
+	words, line, err := splitShell("first\nsecond 'open\nthird")
+	chk.Err(err, chk.ErrChain(ErrSyntax, "unterminated quote (')"))
+	chk.Int(line, 2)
+	chk.Int(len(words), 0)
+
+	words, line, err = splitShell("first\n\"open")
+	chk.Err(err, chk.ErrChain(ErrSyntax, "unterminated quote (\")"))
+	chk.Int(line, 2)
+	chk.Int(len(words), 0)
+
+	words, line, err = splitShell("first\nsecond\\")
+	chk.Err(err, chk.ErrChain(ErrSyntax, "trailing escape"))
+	chk.Int(line, 2)
+	chk.Int(len(words), 0)
+}
@@ -0,0 +1,177 @@
+/*
+   Szerszam argument library: szargs.
+   Copyright (C) 2024-2025  Leslie Dancsecs
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU General Public License for more details.
+
+   You should have received a copy of the GNU General Public License
+   along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package szargs
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// streamMarker is the positional argument replaced by the arguments read from
+// the argument stream.
+const streamMarker = "-"
+
+// argStream provides positional arguments read one at a time from a reader.
+type argStream struct {
+	reader    *bufio.Reader
+	delimiter byte
+	whenEmpty bool
+	active    bool
+	pending   []string
+	eof       bool
+}
+
+// SetArgStream declares that positional arguments may be read from the
+// reader (IE: os.Stdin fed by "find -print0").  The arguments are separated
+// by the delimiter (IE: '\n' or 0) with empty arguments ignored.  A trailing
+// carriage return is removed from newline delimited arguments.
+//
+// The arguments read take the place of a "-" positional argument and, if
+// whenEmpty is true, are also used once no positional arguments remain.
+// They are read as needed by the Next methods so the list never needs to be
+// held in memory all at once.  The Rest methods include them and the Last
+// methods read the stream to its end if it takes the place of the last
+// argument.  Errors reading the stream are registered.
+func (args *Args) SetArgStream(
+	reader io.Reader, delimiter byte, whenEmpty bool,
+) {
+	args.stream = &argStream{
+		reader:    bufio.NewReader(reader),
+		delimiter: delimiter,
+		whenEmpty: whenEmpty,
+		active:    false,
+		pending:   nil,
+		eof:       false,
+	}
+}
+
+// streamArg returns the next argument read from the argument stream if the
+// stream takes the place of the next positional argument.
+func (args *Args) streamArg() (string, bool) {
+	if !args.streamHasNext() {
+		return "", false
+	}
+
+	arg := args.stream.pending[0]
+	args.stream.pending = args.stream.pending[1:]
+
+	return arg, true
+}
+
+// streamLast returns the last argument read from the argument stream if the
+// stream takes the place of the last positional argument.  The stream is read
+// to its end.  A trailing "-" positional argument is removed once the stream
+// holds no further arguments.
+func (args *Args) streamLast() (string, bool) {
+	stream := args.stream
+
+	if stream == nil {
+		return "", false
+	}
+
+	idx := args.lastIndex()
+	atEnd := idx >= 0 && args.args[idx] == streamMarker
+
+	if !atEnd && (idx >= 0 || !stream.active && !stream.whenEmpty) {
+		return "", false
+	}
+
+	for !stream.eof {
+		args.readStream()
+	}
+
+	if len(stream.pending) == 0 {
+		if atEnd {
+			args.dropArg(idx)
+		}
+
+		return "", false
+	}
+
+	last := stream.pending[len(stream.pending)-1]
+	stream.pending = stream.pending[:len(stream.pending)-1]
+
+	return last, true
+}
+
+// streamHasNext returns true if the argument stream takes the place of the
+// next positional argument and holds another argument.  A leading "-"
+// positional argument is consumed activating the stream.
+func (args *Args) streamHasNext() bool {
+	stream := args.stream
+
+	if stream == nil {
+		return false
+	}
+
+	if !stream.active {
+		switch {
+		case len(args.args) > 0 && args.args[0] == streamMarker:
+			args.dropArg(0)
+			stream.active = true
+		case stream.whenEmpty && len(args.positionalArgs()) == 0:
+			stream.active = true
+		default:
+			return false
+		}
+	}
+
+	if len(stream.pending) == 0 {
+		args.readStream()
+	}
+
+	if len(stream.pending) == 0 {
+		stream.active = false
+
+		return false
+	}
+
+	return true
+}
+
+// readStream reads the next non empty argument from the argument stream.
+func (args *Args) readStream() {
+	stream := args.stream
+
+	for !stream.eof {
+		item, err := stream.reader.ReadString(stream.delimiter)
+		item = strings.TrimSuffix(item, string(stream.delimiter))
+
+		if stream.delimiter == '\n' {
+			item = strings.TrimSuffix(item, "\r")
+		}
+
+		if err != nil {
+			stream.eof = true
+
+			if !errors.Is(err, io.EOF) {
+				args.PushErr(fmt.Errorf("%w: %w", ErrArgStream, err))
+			}
+		}
+
+		if item != "" {
+			stream.pending = append(stream.pending, item)
+
+			return
+		}
+	}
+}
@@ -0,0 +1,231 @@
+/*
+   Szerszam argument library: szargs.
+   Copyright (C) 2024-2025  Leslie Dancsecs
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU General Public License for more details.
+
+   You should have received a copy of the GNU General Public License
+   along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package szargs_test
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/dancsecs/szargs"
+	"github.com/dancsecs/sztestlog"
+)
+
+func TestSzargs_ArgStream_Marker(t *testing.T) {
+	chk := sztestlog.CaptureNothing(t)
+	defer chk.Release()
+
+	args := szargs.New("program description", []string{
+		"programName",
+		"first",
+		"-",
+		"last",
+	})
+
+	args.SetArgStream(strings.NewReader("a\r\n\nb\nc"), '\n', false)
+
+	var got []string
+
+	for args.HasNext() {
+		got = append(got, args.NextString("file", "a file"))
+	}
+
+	args.Done()
+
+	chk.NoErr(args.Err())
+	chk.StrSlice(got, []string{"first", "a", "b", "c", "last"})
+}
+
+func TestSzargs_ArgStream_MarkerEmpty(t *testing.T) {
+	chk := sztestlog.CaptureNothing(t)
+	defer chk.Release()
+
+	args := szargs.New("program description", []string{
+		"programName",
+		"-",
+	})
+
+	args.SetArgStream(strings.NewReader(""), '\n', false)
+
+	chk.False(args.HasNext())
+
+	args.Done()
+
+	chk.NoErr(args.Err())
+}
+
+func TestSzargs_ArgStream_WhenEmpty(t *testing.T) {
+	chk := sztestlog.CaptureNothing(t)
+	defer chk.Release()
+
+	args := szargs.New("program description", []string{
+		"programName",
+		"-v",
+		"10",
+	})
+
+	args.SetArgStream(strings.NewReader("20\x0030\x00"), 0, true)
+
+	chk.True(args.Is("[-v]", "verbose"))
+
+	chk.Int(args.NextInt("n", "a number"), 10)
+	chk.Int(args.NextInt("n", "a number"), 20)
+	chk.Int(args.NextInt("n", "a number"), 30)
+	chk.False(args.HasNext())
+	chk.Int(args.NextInt("n", "a number"), 0)
+
+	chk.Err(
+		args.Err(),
+		chk.ErrChain(szargs.ErrMissing, "n"),
+	)
+}
+
+func TestSzargs_ArgStream_NotWhenEmpty(t *testing.T) {
+	chk := sztestlog.CaptureNothing(t)
+	defer chk.Release()
+
+	args := szargs.New("program description", []string{
+		"programName",
+	})
+
+	args.SetArgStream(strings.NewReader("unread"), '\n', false)
+
+	chk.False(args.HasNext())
+	chk.Str(args.NextStringOr("file", "def", "a file"), "def")
+	chk.NoErr(args.Err())
+}
+
+type errReader struct{}
+
+var errTstRead = errors.New("read failed")
+
+func (errReader) Read([]byte) (int, error) {
+	return 0, errTstRead
+}
+
+func TestSzargs_ArgStream_Error(t *testing.T) {
+	chk := sztestlog.CaptureNothing(t)
+	defer chk.Release()
+
+	args := szargs.New("program description", []string{
+		"programName",
+		"-",
+	})
+
+	args.SetArgStream(errReader{}, '\n', false)
+
+	chk.False(args.HasNext())
+	chk.Err(
+		args.Err(),
+		chk.ErrChain(szargs.ErrArgStream, errTstRead),
+	)
+}
+
+func TestSzargs_ArgStream_Rest(t *testing.T) {
+	chk := sztestlog.CaptureNothing(t)
+	defer chk.Release()
+
+	args := szargs.New("program description", []string{
+		"programName",
+		"x",
+		"-",
+	})
+
+	args.SetArgStream(strings.NewReader("a\nb\n"), '\n', false)
+
+	chk.StrSlice(
+		args.RestString("FILE...", 0, -1, "the files"),
+		[]string{"x", "a", "b"},
+	)
+
+	args.Done()
+
+	chk.NoErr(args.Err())
+}
+
+func TestSzargs_ArgStream_Last(t *testing.T) {
+	chk := sztestlog.CaptureNothing(t)
+	defer chk.Release()
+
+	args := szargs.New("program description", []string{
+		"programName",
+		"x",
+		"-",
+	})
+
+	args.SetArgStream(strings.NewReader("a\nb\nc\n"), '\n', false)
+
+	chk.Str(args.LastString("DEST", "the destination"), "c")
+	chk.StrSlice(
+		args.RestString("SRC...", 1, -1, "the sources"),
+		[]string{"x", "a", "b"},
+	)
+
+	args.Done()
+
+	chk.NoErr(args.Err())
+
+	args = szargs.New("program description", []string{
+		"programName",
+		"x",
+		"-",
+	})
+
+	args.SetArgStream(strings.NewReader(""), '\n', false)
+
+	chk.Str(args.LastString("DEST", "the destination"), "x")
+	chk.False(args.HasNext())
+
+	args = szargs.New("program description", []string{
+		"programName",
+	})
+
+	args.SetArgStream(strings.NewReader("a\nb\n"), '\n', true)
+
+	chk.Str(args.LastString("DEST", "the destination"), "b")
+	chk.Str(args.NextString("SRC", "the source"), "a")
+	chk.False(args.HasNext())
+
+	args.Done()
+
+	chk.NoErr(args.Err())
+}
+
+func TestSzargs_ArgStream_Done(t *testing.T) {
+	chk := sztestlog.CaptureNothing(t)
+	defer chk.Release()
+
+	args := szargs.New("program description", []string{
+		"programName",
+	})
+
+	args.SetArgStream(strings.NewReader("b\nc\n"), '\n', true)
+
+	chk.Str(args.NextString("file", "a file"), "b")
+
+	args.Done()
+
+	chk.Err(
+		args.Err(),
+		chk.ErrChain(
+			szargs.ErrUnexpected,
+			"[c] from argument stream",
+		),
+	)
+}
@@ -0,0 +1,170 @@
+/*
+   Szerszam argument library: szargs.
+   Copyright (C) 2024-2025  Leslie Dancsecs
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU General Public License for more details.
+
+   You should have received a copy of the GNU General Public License
+   along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package szargs
+
+import (
+	"encoding"
+	"errors"
+	"fmt"
+)
+
+// TextTarget is satisfied by a pointer to any type implementing
+// encoding.TextUnmarshaler.
+type TextTarget[T any] interface {
+	*T
+	encoding.TextUnmarshaler
+}
+
+// FlagTarget is satisfied by a pointer to any type implementing the Set
+// method of the standard library's flag.Value interface.
+type FlagTarget[T any] interface {
+	*T
+	Set(str string) error
+}
+
+func makeDecodeErr(err error, name, str string) error {
+	return fmt.Errorf(
+		"%w: %w", makeParseErr(ErrInvalidText, err, name, str), err,
+	)
+}
+
+// TextParser returns a parser decoding arguments with the UnmarshalText
+// method of T.
+func TextParser[T any, P TextTarget[T]]() Parser[T] {
+	return ParserFunc[T](func(name, str string) (T, error) {
+		var result T
+
+		err := P(&result).UnmarshalText([]byte(str))
+		if err != nil {
+			err = makeDecodeErr(err, name, str)
+		}
+
+		return result, err
+	})
+}
+
+// FlagParser returns a parser decoding arguments with the Set method of T
+// as implemented by types satisfying the standard library's flag.Value
+// interface.
+func FlagParser[T any, P FlagTarget[T]]() Parser[T] {
+	return ParserFunc[T](func(name, str string) (T, error) {
+		var result T
+
+		err := P(&result).Set(str)
+		if err != nil {
+			err = makeDecodeErr(err, name, str)
+		}
+
+		return result, err
+	})
+}
+
+// ValueText scans for a specific flagged argument and decodes its value into
+// the target. The flag and its value are removed from the argument list.
+//
+// If the flag appears more than once, lacks a following value, or if the
+// target rejects the value, an error is registered.
+//
+// Returns a boolean indicating whether the flag was found.
+func (args *Args) ValueText(
+	flag string, target encoding.TextUnmarshaler, desc string,
+) bool {
+	args.RegisterUsage(flag, desc)
+
+	arg, from, found, err := args.scanValue(flag)
+
+	if err == nil && found {
+		err = args.noteOrigin(decodeText(target, flag, arg), from)
+		if err != nil {
+			found = false
+		}
+	}
+
+	args.PushErr(err)
+
+	return found
+}
+
+// ValuesText scans for repeated instances of the specified flag and decodes
+// each of the following values into a new T using its UnmarshalText method.
+// The flags and values are removed from the argument list.
+//
+// If any flag lacks a following value, or if a value is rejected, an error is
+// registered.
+//
+// Returns a slice of the decoded values.
+func ValuesText[T any, P TextTarget[T]](args *Args, flag, desc string) []T {
+	return Values(args, TextParser[T, P](), flag, desc)
+}
+
+// SettingText decodes a configuration value into the target from an
+// environment variable, further overridden by a flagged command-line
+// argument. The current contents of the target act as the default and are
+// left unchanged when neither is provided.
+//
+// If the final value is rejected by the target, an error is registered.
+func (args *Args) SettingText(
+	flag, env string, target encoding.TextUnmarshaler, desc string,
+) {
+	args.RegisterUsage(flag, desc)
+
+	value, from, srcErr, err := args.scanSetting(flag, env, defaultStandIn)
+
+	if err == nil && value != defaultStandIn {
+		parseName := flag
+		if errors.Is(srcErr, ErrInvalidEnv) {
+			parseName = env
+		}
+
+		err = args.noteOrigin(decodeText(target, parseName, value), from)
+	}
+
+	if err != nil {
+		args.PushErr(srcErr)
+		args.PushErr(err)
+	}
+}
+
+// NextText removes the next argument from the argument list and decodes it
+// into the target.
+//
+// If no arguments remain, or if the target rejects the value, an error is
+// registered.
+func (args *Args) NextText(
+	name string, target encoding.TextUnmarshaler, desc string,
+) {
+	args.RegisterUsage(name, desc)
+
+	arg, from, err := args.nextArg(name)
+
+	if err == nil {
+		err = args.noteOrigin(decodeText(target, name, arg), from)
+	}
+
+	args.PushErr(err)
+}
+
+func decodeText(target encoding.TextUnmarshaler, name, str string) error {
+	err := target.UnmarshalText([]byte(str))
+	if err != nil {
+		err = makeDecodeErr(err, name, str)
+	}
+
+	return err
+}
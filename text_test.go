@@ -0,0 +1,230 @@
+/*
+   Szerszam argument library: szargs.
+   Copyright (C) 2024-2025  Leslie Dancsecs
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU General Public License for more details.
+
+   You should have received a copy of the GNU General Public License
+   along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package szargs_test
+
+import (
+	"errors"
+	"log/slog"
+	"strings"
+	"testing"
+
+	"github.com/dancsecs/szargs"
+	"github.com/dancsecs/sztestlog"
+)
+
+var errTstColor = errors.New("unknown color")
+
+type tstColor string
+
+func (c *tstColor) Set(str string) error {
+	switch strings.ToLower(str) {
+	case "red", "green", "blue":
+		*c = tstColor(strings.ToLower(str))
+
+		return nil
+	}
+
+	return errTstColor
+}
+
+func TestSzargs_ValueText(t *testing.T) {
+	chk := sztestlog.CaptureNothing(t)
+	defer chk.Release()
+
+	args := szargs.New("program description", []string{
+		"programName",
+		"--level", "debug+2",
+		"arg",
+	})
+
+	level := slog.LevelInfo
+	missing := slog.LevelWarn
+
+	chk.True(args.ValueText("[--level lvl]", &level, "the log level"))
+	chk.False(args.ValueText("[--missing lvl]", &missing, "not present"))
+
+	chk.Int(int(level), int(slog.LevelDebug+2))
+	chk.Int(int(missing), int(slog.LevelWarn))
+	chk.NoErr(args.Err())
+	chk.StrSlice(args.Args(), []string{"arg"})
+}
+
+func TestSzargs_ValueText_Invalid(t *testing.T) {
+	chk := sztestlog.CaptureNothing(t)
+	defer chk.Release()
+
+	args := szargs.New("program description", []string{
+		"programName",
+		"--level", "bogus",
+	})
+
+	level := slog.LevelInfo
+
+	chk.False(args.ValueText("[--level lvl]", &level, "the log level"))
+	chk.Int(int(level), int(slog.LevelInfo))
+	chk.Err(
+		args.Err(),
+		chk.ErrChain(
+			szargs.ErrInvalidText,
+			szargs.ErrSyntax,
+			"[--level lvl]",
+			"'bogus'",
+			`slog: level string "bogus": unknown name`,
+		),
+	)
+}
+
+func TestSzargs_ValuesText(t *testing.T) {
+	chk := sztestlog.CaptureNothing(t)
+	defer chk.Release()
+
+	args := szargs.New("program description", []string{
+		"programName",
+		"-l", "error",
+		"-l", "WARN",
+	})
+
+	levels := szargs.ValuesText[slog.Level](args, "[-l lvl ...]", "levels")
+
+	chk.Int(len(levels), 2)
+	chk.Int(int(levels[0]), int(slog.LevelError))
+	chk.Int(int(levels[1]), int(slog.LevelWarn))
+	chk.NoErr(args.Err())
+
+	args = szargs.New("program description", []string{
+		"programName",
+		"-l", "loud",
+	})
+
+	levels = szargs.ValuesText[slog.Level](args, "[-l lvl ...]", "levels")
+
+	chk.Int(len(levels), 0)
+	chk.Err(
+		args.Err(),
+		chk.ErrChain(
+			szargs.ErrInvalidText,
+			szargs.ErrSyntax,
+			"[-l lvl ...]",
+			"'loud'",
+			`slog: level string "loud": unknown name`,
+		),
+	)
+}
+
+func TestSzargs_SettingText(t *testing.T) {
+	chk := sztestlog.CaptureNothing(t)
+	defer chk.Release()
+
+	args := szargs.New("program description", []string{
+		"programName",
+	})
+
+	level := slog.LevelInfo
+
+	args.SettingText(tstArgFlag, tstEnv, &level, "the log level")
+	chk.Int(int(level), int(slog.LevelInfo))
+
+	chk.SetEnv(tstEnv, "warn")
+
+	args.SettingText(tstArgFlag, tstEnv, &level, "the log level")
+	chk.Int(int(level), int(slog.LevelWarn))
+
+	args = szargs.New("program description", []string{
+		"programName",
+		tstArg, "error",
+	})
+
+	args.SettingText(tstArgFlag, tstEnv, &level, "the log level")
+	chk.Int(int(level), int(slog.LevelError))
+	chk.NoErr(args.Err())
+
+	chk.SetEnv(tstEnv, "quiet")
+
+	args.SettingText(tstArgFlag, tstEnv, &level, "the log level")
+	chk.Int(int(level), int(slog.LevelError))
+	chk.Err(
+		args.Err(),
+		chk.ErrChain(
+			szargs.ErrInvalidEnv,
+			szargs.ErrInvalidText,
+			szargs.ErrSyntax,
+			tstEnv,
+			"'quiet'",
+			`slog: level string "quiet": unknown name`,
+		),
+	)
+}
+
+func TestSzargs_NextText(t *testing.T) {
+	chk := sztestlog.CaptureNothing(t)
+	defer chk.Release()
+
+	args := szargs.New("program description", []string{
+		"programName",
+		"debug",
+	})
+
+	var first, second slog.Level
+
+	args.NextText("first", &first, "the first level")
+	args.NextText("second", &second, "the second level")
+
+	chk.Int(int(first), int(slog.LevelDebug))
+	chk.Err(
+		args.Err(),
+		chk.ErrChain(
+			szargs.ErrMissing,
+			"second",
+		),
+	)
+}
+
+func TestSzargs_FlagParser(t *testing.T) {
+	chk := sztestlog.CaptureNothing(t)
+	defer chk.Release()
+
+	args := szargs.New("program description", []string{
+		"programName",
+		"--color", "Red",
+		"purple",
+	})
+
+	color, found := szargs.Value(
+		args, szargs.FlagParser[tstColor](), "[--color c]", "the color",
+	)
+
+	chk.True(found)
+	chk.Str(string(color), "red")
+
+	color = szargs.Next(
+		args, szargs.FlagParser[tstColor](), "color", "the color",
+	)
+
+	chk.Str(string(color), "")
+	chk.Err(
+		args.Err(),
+		chk.ErrChain(
+			szargs.ErrInvalidText,
+			szargs.ErrSyntax,
+			"color",
+			"'purple'",
+			errTstColor.Error(),
+		),
+	)
+}
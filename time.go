@@ -0,0 +1,270 @@
+/*
+   Szerszam argument library: szargs.
+   Copyright (C) 2024-2025  Leslie Dancsecs
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU General Public License for more details.
+
+   You should have received a copy of the GNU General Public License
+   along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package szargs
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	timeNow      = "now"
+	timeToday    = "today"
+	timeAgo      = " ago"
+	defaultZone  = "Local"
+	timeExpected = "must be now, today, a relative offset " +
+		"(IE: -2h, +1d12h, 3d ago) or match one of %q"
+)
+
+// defaultTimeLayouts are always accepted ahead of any custom layouts.
+var defaultTimeLayouts = []string{
+	time.RFC3339,
+	time.DateTime,
+	time.DateOnly,
+}
+
+// relativeDays matches a leading number of days in a relative offset.
+var relativeDays = regexp.MustCompile(`^(\d+)d(.*)$`)
+
+// TimeParser returns a parser accepting the current time ("now"), the start
+// of the current day ("today"), an offset relative to the current time
+// (IE: "-2h", "+1d12h" or "3d ago") or a time matching RFC 3339, "2006-01-02
+// 15:04:05", "2006-01-02" or any of the provided layouts. Times without a
+// zone are interpreted in the provided location (time.Local if nil).
+func TimeParser(loc *time.Location, layouts ...string) Parser[time.Time] {
+	layouts = append(slices.Clone(defaultTimeLayouts), layouts...)
+
+	if loc == nil {
+		loc = time.Local
+	}
+
+	return ParserFunc[time.Time](func(name, str string) (time.Time, error) {
+		return parseTime(name, str, time.Now(), loc, layouts)
+	})
+}
+
+func parseTime(
+	name, str string, now time.Time, loc *time.Location, layouts []string,
+) (time.Time, error) {
+	var (
+		result time.Time
+		err    error
+	)
+
+	now = now.In(loc)
+
+	switch {
+	case strings.EqualFold(str, timeNow):
+		result = now
+	case strings.EqualFold(str, timeToday):
+		year, month, day := now.Date()
+		result = time.Date(year, month, day, 0, 0, 0, 0, loc)
+	case strings.HasPrefix(str, "+"):
+		result, err = relativeTime(now, str[1:], 1)
+	case strings.HasPrefix(str, "-"):
+		result, err = relativeTime(now, str[1:], -1)
+	case strings.HasSuffix(str, timeAgo):
+		result, err = relativeTime(now, strings.TrimSuffix(str, timeAgo), -1)
+	default:
+		for _, layout := range layouts {
+			result, err = time.ParseInLocation(layout, str, loc)
+			if err == nil {
+				break
+			}
+		}
+	}
+
+	if err != nil {
+		return time.Time{}, fmt.Errorf(
+			"%w ("+timeExpected+")",
+			makeParseErr(ErrInvalidTime, err, name, str),
+			layouts,
+		)
+	}
+
+	return result, nil
+}
+
+// relativeTime returns the time offset from now by a duration in Go syntax
+// optionally preceded by a number of (calendar) days.
+func relativeTime(now time.Time, str string, sign int) (time.Time, error) {
+	var (
+		days   int
+		offset time.Duration
+		err    error
+	)
+
+	if match := relativeDays.FindStringSubmatch(str); match != nil {
+		days, err = strconv.Atoi(match[1])
+		if err != nil || days > math.MaxInt32 {
+			return time.Time{}, strconv.ErrRange
+		}
+
+		str = match[2]
+	}
+
+	if str != "" || days == 0 {
+		offset, err = time.ParseDuration(str)
+		if err != nil {
+			if durationSyntax.MatchString(str) {
+				err = strconv.ErrRange
+			}
+
+			return time.Time{}, err
+		}
+	}
+
+	return now.AddDate(0, 0, sign*days).Add(time.Duration(sign) * offset), nil
+}
+
+// SetTimeLayouts sets additional layouts (see time.Parse) accepted by the
+// Time family of methods after RFC 3339, "2006-01-02 15:04:05" and
+// "2006-01-02".
+func (args *Args) SetTimeLayouts(layouts ...string) {
+	args.timeLayouts = slices.Clone(layouts)
+}
+
+// SetTimeLocation sets the location used by the Time family of methods to
+// interpret times without a zone and the start of the day for "today". A nil
+// location selects time.Local (the default).
+func (args *Args) SetTimeLocation(loc *time.Location) {
+	args.timeLocation = loc
+}
+
+// SettingTimeZone selects a time zone from the local zone that can be
+// overridden by an environment variable (usually "TZ") which can be
+// overridden by a flagged value. The zone is loaded with time.LoadLocation
+// and becomes the location used by the Time family of methods.
+//
+// If the zone cannot be loaded an error is registered.
+//
+// Returns the selected location.
+func (args *Args) SettingTimeZone(flag, env, desc string) *time.Location {
+	var (
+		value     string
+		from      origin
+		loc       *time.Location
+		parseName string
+		srcErr    error
+		err       error
+	)
+
+	args.RegisterUsage(flag, desc)
+
+	value, from, srcErr, err = args.scanSetting(flag, env, defaultZone)
+
+	if err == nil {
+		loc, err = time.LoadLocation(value)
+		if err == nil {
+			args.timeLocation = loc
+		} else {
+			if errors.Is(srcErr, ErrInvalidEnv) {
+				parseName = env
+			} else {
+				parseName = flag
+			}
+
+			err = args.noteOrigin(
+				fmt.Errorf(
+					"%w: %s: '%s': %w",
+					ErrInvalidTimeZone,
+					parseName,
+					value,
+					err,
+				),
+				from,
+			)
+		}
+	}
+
+	if err != nil {
+		args.PushErr(srcErr)
+		args.PushErr(err)
+	}
+
+	return args.location()
+}
+
+// location returns the location used by the Time family of methods.
+func (args *Args) location() *time.Location {
+	if args.timeLocation == nil {
+		return time.Local
+	}
+
+	return args.timeLocation
+}
+
+// timeParser returns the parser used by the Time family of methods.
+func (args *Args) timeParser() Parser[time.Time] {
+	return TimeParser(args.location(), args.timeLayouts...)
+}
+
+// ValueTime scans for a specific flagged argument and parses its value as a
+// time.Time. The flag and its value are removed from the argument list.
+//
+// If the flag appears more than once, lacks a following value, or if the
+// value is not an accepted time, an error is registered.
+//
+// Returns the parsed value and a boolean indicating whether the flag was
+// found.
+func (args *Args) ValueTime(flag, desc string) (time.Time, bool) {
+	return Value(args, args.timeParser(), flag, desc)
+}
+
+// ValuesTime scans for repeated instances of the specified flag and parses
+// the following values as time.Times. The flags and values are removed from
+// the argument list.
+//
+// If any flag lacks a following value, or if a value is not an accepted
+// time, an error is registered.
+//
+// Returns a slice of the parsed time.Time values.
+func (args *Args) ValuesTime(flag, desc string) []time.Time {
+	return Values(args, args.timeParser(), flag, desc)
+}
+
+// SettingTime returns a configuration value based on a default, optionally
+// overridden by an environment variable, and further overridden by a flagged
+// command-line argument. The value is parsed as a time.Time.
+//
+// If the final value is not an accepted time, an error is registered.
+//
+// Returns the final parsed time.Time value.
+func (args *Args) SettingTime(
+	flag, env string, def time.Time, desc string,
+) time.Time {
+	return Setting(args, args.timeParser(), flag, env, def, desc)
+}
+
+// NextTime removes and returns the next argument from the argument list,
+// parsing it as a time.Time.
+//
+// If no arguments remain, or if the value is not an accepted time, an error
+// is registered.
+//
+// Returns the next argument value parsed as a time.Time.
+func (args *Args) NextTime(name, desc string) time.Time {
+	return Next(args, args.timeParser(), name, desc)
+}
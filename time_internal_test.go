@@ -0,0 +1,125 @@
+/*
+   Szerszam argument library: szargs.
+   Copyright (C) 2024-2025  Leslie Dancsecs
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU General Public License for more details.
+
+   You should have received a copy of the GNU General Public License
+   along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package szargs
+
+import (
+	"testing"
+	"time"
+
+	"github.com/dancsecs/sztestlog"
+)
+
+func TestSzargs_ParseTime(t *testing.T) {
+	chk := sztestlog.CaptureNothing(t)
+	defer chk.Release()
+
+	loc := time.FixedZone("TST", -5*60*60)
+	now := time.Date(2025, 3, 10, 14, 30, 0, 0, time.UTC)
+	layouts := append([]string{}, defaultTimeLayouts...)
+	layouts = append(layouts, "02/01/2006")
+
+	check := func(str, want string) {
+		t.Helper()
+
+		result, err := parseTime("--since", str, now, loc, layouts)
+		chk.NoErr(err)
+		chk.Str(result.Format(time.RFC3339), want)
+	}
+
+	check("now", "2025-03-10T09:30:00-05:00")
+	check("Today", "2025-03-10T00:00:00-05:00")
+	check("-2h", "2025-03-10T07:30:00-05:00")
+	check("+1d12h", "2025-03-11T21:30:00-05:00")
+	check("3d ago", "2025-03-07T09:30:00-05:00")
+	check("90m ago", "2025-03-10T08:00:00-05:00")
+	check("2024-02-29T10:00:00Z", "2024-02-29T10:00:00Z")
+	check("2024-02-29 10:00:00", "2024-02-29T10:00:00-05:00")
+	check("2024-02-29", "2024-02-29T00:00:00-05:00")
+	check("29/02/2024", "2024-02-29T00:00:00-05:00")
+}
+
+func TestSzargs_ParseTime_Invalid(t *testing.T) {
+	chk := sztestlog.CaptureNothing(t)
+	defer chk.Release()
+
+	now := time.Date(2025, 3, 10, 14, 30, 0, 0, time.UTC)
+	expected := " (must be now, today, a relative offset " +
+		"(IE: -2h, +1d12h, 3d ago) or match one of " +
+		`["2006-01-02T15:04:05Z07:00" "2006-01-02 15:04:05" "2006-01-02"])`
+
+	result, err := parseTime("--since", "yesterday", now, time.UTC,
+		defaultTimeLayouts,
+	)
+	chk.Err(
+		err,
+		chk.ErrChain(
+			ErrInvalidTime,
+			ErrSyntax,
+			"--since",
+			"'yesterday'"+expected,
+		),
+	)
+	chk.True(result.IsZero())
+
+	result, err = parseTime("--since", "2d ago", now, time.UTC, nil)
+	chk.NoErr(err)
+	chk.Str(result.Format(time.RFC3339), "2025-03-08T14:30:00Z")
+
+	result, err = parseTime("--since", "-2x", now, time.UTC,
+		defaultTimeLayouts,
+	)
+	chk.Err(
+		err,
+		chk.ErrChain(
+			ErrInvalidTime,
+			ErrSyntax,
+			"--since",
+			"'-2x'"+expected,
+		),
+	)
+	chk.True(result.IsZero())
+
+	result, err = parseTime("--since", "-3000000h", now, time.UTC,
+		defaultTimeLayouts,
+	)
+	chk.Err(
+		err,
+		chk.ErrChain(
+			ErrInvalidTime,
+			ErrRange,
+			"--since",
+			"'-3000000h'"+expected,
+		),
+	)
+	chk.True(result.IsZero())
+
+	result, err = parseTime("--since", "99999999999d ago", now, time.UTC,
+		defaultTimeLayouts,
+	)
+	chk.Err(
+		err,
+		chk.ErrChain(
+			ErrInvalidTime,
+			ErrRange,
+			"--since",
+			"'99999999999d ago'"+expected,
+		),
+	)
+	chk.True(result.IsZero())
+}
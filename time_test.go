@@ -0,0 +1,134 @@
+/*
+   Szerszam argument library: szargs.
+   Copyright (C) 2024-2025  Leslie Dancsecs
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU General Public License for more details.
+
+   You should have received a copy of the GNU General Public License
+   along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package szargs_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/dancsecs/szargs"
+	"github.com/dancsecs/sztestlog"
+)
+
+func TestSzargs_Time(t *testing.T) {
+	chk := sztestlog.CaptureNothing(t)
+	defer chk.Release()
+
+	args := szargs.New("program description", []string{
+		"programName",
+		"--since", "2025-01-02",
+		"--at", "2025-01-02T03:04:05Z",
+		"--at", "03/01/2025 10:00",
+		"-2h",
+	})
+
+	args.SetTimeLocation(time.UTC)
+	args.SetTimeLayouts("02/01/2006 15:04")
+
+	since, found := args.ValueTime("[--since time]", "the start")
+
+	chk.True(found)
+	chk.Str(since.Format(time.RFC3339), "2025-01-02T00:00:00Z")
+
+	ats := args.ValuesTime("[--at time ...]", "the times")
+
+	chk.Int(len(ats), 2)
+	chk.Str(ats[0].Format(time.RFC3339), "2025-01-02T03:04:05Z")
+	chk.Str(ats[1].Format(time.RFC3339), "2025-01-03T10:00:00Z")
+
+	def := time.Date(2000, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	chk.True(args.SettingTime(tstArgFlag, tstEnv, def, "d").Equal(def))
+
+	before := time.Now().Add(-2 * time.Hour)
+	offset := args.NextTime("offset", "the offset")
+
+	chk.False(offset.Before(before))
+	chk.False(offset.After(time.Now().Add(-2 * time.Hour)))
+
+	args.Done()
+
+	chk.NoErr(args.Err())
+}
+
+func TestSzargs_Time_Invalid(t *testing.T) {
+	chk := sztestlog.CaptureNothing(t)
+	defer chk.Release()
+
+	args := szargs.New("program description", []string{
+		"programName",
+		"--since", "last week",
+	})
+
+	since, found := args.ValueTime("[--since time]", "the start")
+
+	chk.False(found)
+	chk.True(since.IsZero())
+	chk.Err(
+		args.Err(),
+		chk.ErrChain(
+			szargs.ErrInvalidTime,
+			szargs.ErrSyntax,
+			"[--since time]",
+			"'last week' (must be now, today, a relative offset "+
+				"(IE: -2h, +1d12h, 3d ago) or match one of "+
+				`["2006-01-02T15:04:05Z07:00" "2006-01-02 15:04:05" `+
+				`"2006-01-02"])`,
+		),
+	)
+}
+
+func TestSzargs_SettingTimeZone(t *testing.T) {
+	chk := sztestlog.CaptureNothing(t)
+	defer chk.Release()
+
+	args := szargs.New("program description", []string{
+		"programName",
+		"2025-06-01 12:00:00",
+	})
+
+	chk.SetEnv(tstEnv, "UTC")
+
+	loc := args.SettingTimeZone("[--tz zone]", tstEnv, "the time zone")
+
+	chk.Str(loc.String(), "UTC")
+	chk.Str(
+		args.NextTime("time", "the time").Format(time.RFC3339),
+		"2025-06-01T12:00:00Z",
+	)
+
+	args = szargs.New("program description", []string{
+		"programName",
+		"--tz", "Not/AZone",
+	})
+
+	loc = args.SettingTimeZone("[--tz zone]", tstEnv, "the time zone")
+
+	chk.Str(loc.String(), time.Local.String())
+	chk.Err(
+		args.Err(),
+		chk.ErrChain(
+			szargs.ErrInvalidFlag,
+			szargs.ErrInvalidTimeZone,
+			"[--tz zone]",
+			"'Not/AZone'",
+			"unknown time zone Not/AZone",
+		),
+	)
+}
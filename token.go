@@ -0,0 +1,500 @@
+/*
+   Szerszam argument library: szargs.
+   Copyright (C) 2024-2025  Leslie Dancsecs
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU General Public License for more details.
+
+   You should have received a copy of the GNU General Public License
+   along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package szargs
+
+import (
+	"fmt"
+	"maps"
+	"slices"
+)
+
+// The number of values taken by a flag.
+const (
+	optionalValue = -1 // A value may only be attached (IE: --color=auto).
+	noValue       = 0
+	singleValue   = 1
+)
+
+// registry maps every registered flag name to the number of values the flag
+// takes.
+type registry map[string]int
+
+// isFlagName returns true if the name identifies a flag rather than a
+// positional argument.
+func isFlagName(name string) bool {
+	return len(name) > 1 && name[0] == '-' && name != endOfOptions
+}
+
+// registerFlag records the names declared in the flag specification along
+// with the number of values the flag takes.
+func (args *Args) registerFlag(flag string, values int) {
+	before := maps.Clone(args.flags)
+
+	for _, name := range argFlag(flag).allNames() {
+		if isFlagName(name) {
+			args.flags[name] = values
+		}
+	}
+
+	args.reclassify(flag, before)
+}
+
+// registerSpec records any flag names declared in a usage specification not
+// already registered.  The flag is considered to take a value for each value
+// the specification names (IE: [-n | --num numOfLines] or [--rect x y w h])
+// or an optional value if marked as such (IE: [--color[=when]]).
+func (args *Args) registerSpec(spec string) {
+	before := maps.Clone(args.flags)
+	values := argFlag(spec).specValues()
+
+	for _, name := range argFlag(spec).allNames() {
+		_, known := args.flags[name]
+		if isFlagName(name) && !known {
+			args.flags[name] = values
+		}
+	}
+
+	args.reclassify(spec, before)
+}
+
+// occurrence records a flag found in an argument list.  The arguments
+// args[start:end] belong to the occurrence.
+type occurrence struct {
+	start    int
+	end      int
+	value    string
+	values   []string
+	hasValue bool
+	negated  bool
+}
+
+// setValues records the values captured for the occurrence noting if all the
+// wanted values were found.
+func (occ *occurrence) setValues(values []string, wanted int) {
+	occ.values = slices.Clone(values)
+	occ.hasValue = len(values) == wanted
+
+	if len(values) > 0 {
+		occ.value = values[0]
+	}
+}
+
+// find locates every occurrence of the flag in the argument list.  Arguments
+// following other registered flags that take a value are skipped so that
+// they are never mistaken for the flag being searched for.
+func (a argFlag) find(
+	args []string, values int, reg registry,
+) []occurrence {
+	var found []occurrence
+
+	for i, mi := 0, len(args); i < mi; i++ {
+		if a.argIs(args[i]) {
+			occ := occurrence{start: i, end: i + 1}
+
+			if values > noValue {
+				occ.end = min(i+1+values, mi)
+				occ.setValues(args[i+1:occ.end], values)
+				i = occ.end - 1
+			}
+
+			found = append(found, occ)
+
+			continue
+		}
+
+		if values != noValue {
+			attached, ok := a.argValue(args[i])
+			if ok {
+				wanted := max(values, singleValue)
+				occ := occurrence{start: i, end: min(i+wanted, mi)}
+				occ.setValues(
+					append([]string{attached}, args[i+1:occ.end]...),
+					wanted,
+				)
+				i = occ.end - 1
+
+				found = append(found, occ)
+
+				continue
+			}
+		}
+
+		if values == noValue && a.argIsNegated(args[i]) {
+			found = append(found, occurrence{
+				start:   i,
+				end:     i + 1,
+				negated: true,
+			})
+
+			continue
+		}
+
+		// Skip any values belonging to another flag.
+		i += max(reg[args[i]], 0)
+	}
+
+	return found
+}
+
+// removeOccurrences returns a new list without the items belonging to the
+// supplied occurrences.
+func removeOccurrences[T any](items []T, found []occurrence) []T {
+	cleaned := make([]T, 0, len(items))
+	next := 0
+
+	for _, occ := range found {
+		cleaned = append(cleaned, items[next:occ.start]...)
+		next = occ.end
+	}
+
+	return append(cleaned, items[next:]...)
+}
+
+// role describes how the tokenizer classified an argument.
+type role int
+
+// Argument roles.
+const (
+	roleArg      role = iota // A flag or positional argument.
+	roleValue                // The value of the preceding flag.
+	roleTrailing             // Follows the end of the flagged arguments.
+)
+
+// token is an argument as classified by the tokenizer.
+type token struct {
+	arg  string
+	role role
+}
+
+// classified holds an argument list as classified by the tokenizer.
+type classified struct {
+	args    []string
+	origins []origin
+	roles   []role
+	errs    map[int]error // Keyed by the id of the argument's origin.
+}
+
+// add appends a classified argument.
+func (c *classified) add(arg string, from origin, r role) {
+	c.args = append(c.args, arg)
+	c.origins = append(c.origins, from)
+	c.roles = append(c.roles, r)
+}
+
+// tokens returns the classified arguments derived from each origin.
+func (c *classified) tokens() map[int][]token {
+	tokens := make(map[int][]token)
+
+	for i, from := range c.origins {
+		tokens[from.id] = append(
+			tokens[from.id], token{arg: c.args[i], role: c.roles[i]},
+		)
+	}
+
+	return tokens
+}
+
+// classify classifies the arguments against the registry.  Arguments given
+// in the configured dialect are rewritten in their POSIX/GNU form, short
+// option groups are expanded (GNU dialect only), long flag abbreviations are
+// resolved (if enabled) and the values of registered flags are left
+// untouched.  A flag provided by the environment variable (see
+// PrependEnvArgs) missing values there is dropped recording an ErrMissing
+// error.  Every argument following an end of options marker (or in
+// POSIXLY_CORRECT mode the first positional argument) is left untouched as
+// well.  An ambiguous abbreviation is dropped recording its error.
+func (args *Args) classify(
+	list []string, origins []origin, reg registry,
+) classified {
+	result := classified{
+		args:    make([]string, 0, len(list)),
+		origins: make([]origin, 0, len(list)),
+		roles:   make([]role, 0, len(list)),
+		errs:    make(map[int]error),
+	}
+
+	for i, mi := 0, len(list); i < mi; i++ {
+		arg := args.dialectArg(list[i], reg)
+
+		if args.terminated ||
+			arg == endOfOptions ||
+			args.posixlyCorrect && !isFlagName(arg) {
+			for ; i < mi; i++ {
+				result.add(list[i], origins[i], roleTrailing)
+			}
+
+			break
+		}
+
+		if args.abbreviate {
+			var err error
+
+			arg, err = args.expandAbbrev(arg, reg)
+			if err != nil {
+				result.errs[origins[i].id] = err
+
+				continue
+			}
+		}
+
+		parts := []string{arg}
+
+		values, known := reg[arg]
+		if !known && args.dialect == DialectGNU {
+			parts, values = args.splitGroup(arg, reg)
+		}
+
+		if origins[i].env && !envValues(origins[i+1:], values) {
+			result.errs[origins[i].id] = args.noteOrigin(
+				fmt.Errorf("%w: '%s'", ErrMissing, arg), origins[i],
+			)
+
+			continue
+		}
+
+		for _, part := range parts {
+			result.add(part, origins[i], roleArg)
+		}
+
+		for ; values > 0 && i+1 < mi; values-- {
+			i++
+			result.add(list[i], origins[i], roleValue)
+		}
+	}
+
+	return result
+}
+
+// envValues returns true if the origins following a flag provided by the
+// environment variable hold all the values the flag takes.  The values of
+// such a flag never come from the command line.
+func envValues(following []origin, values int) bool {
+	if values > len(following) {
+		return false
+	}
+
+	for _, from := range following[:max(values, 0)] {
+		if !from.env {
+			return false
+		}
+	}
+
+	return true
+}
+
+// snapshot records the argument list as it was when first tokenized.
+type snapshot struct {
+	args    []string
+	origins []origin
+}
+
+// tokenize classifies the argument list the first time arguments are
+// extracted recording the list as it was.  The arguments are then only
+// reclassified if a flag registered later changes their meaning (see
+// reclassify).
+func (args *Args) tokenize() {
+	if args.sealed != nil {
+		return
+	}
+
+	args.sealed = &snapshot{
+		args:    slices.Clone(args.args),
+		origins: slices.Clone(args.origins),
+	}
+
+	result := args.classify(args.args, args.origins, args.flags)
+	args.setArgs(result.args, result.origins)
+
+	for _, from := range args.sealed.origins {
+		args.PushErr(result.errs[from.id])
+	}
+}
+
+// scanLimit returns the index of the end of options marker or (in
+// POSIXLY_CORRECT mode) the first positional argument ending the arguments
+// that may be scanned for flags or the length of the argument list if there
+// is none.  The values of registered flags are skipped.
+func (args *Args) scanLimit() int {
+	if args.terminated {
+		return 0
+	}
+
+	for i := 0; i < len(args.args); i++ {
+		arg := args.args[i]
+
+		switch {
+		case arg == endOfOptions, args.posixlyCorrect && !isFlagName(arg):
+			return i
+		case isFlagName(arg):
+			i += max(args.flags[arg], 0)
+		}
+	}
+
+	return len(args.args)
+}
+
+// reclassify reconciles the arguments with a flag registered after they
+// were first tokenized.  The arguments as first given are classified against
+// the registry both before and after the registration.  The remaining
+// arguments whose classification changed are replaced by their new
+// classification.  If an argument already extracted changed (or a remaining
+// one would now match a flag already extracted) the earlier results depended
+// on the order of extraction and an ErrLateFlag error is registered (or the
+// ErrAmbiguous error of an abbreviation that became ambiguous).  Arguments
+// already extracted are left as they were.
+func (args *Args) reclassify(spec string, before registry) {
+	if args.sealed == nil || maps.Equal(before, args.flags) {
+		return
+	}
+
+	snap := args.sealed
+	previous := args.classify(snap.args, snap.origins, before)
+	result := args.classify(snap.args, snap.origins, args.flags)
+	oldTokens := previous.tokens()
+	newTokens := result.tokens()
+
+	current := make(map[int][]string)
+	for i, from := range args.origins {
+		current[from.id] = append(current[from.id], args.args[i])
+	}
+
+	replacements := make(map[int][]token)
+
+	for i, from := range snap.origins {
+		id := from.id
+
+		if slices.Equal(oldTokens[id], newTokens[id]) {
+			continue
+		}
+
+		extracted := withoutArgs(oldTokens[id], current[id])
+		remaining, unchanged := withoutTokens(newTokens[id], extracted)
+		unchanged = unchanged && (len(current[id]) > 0 || len(remaining) == 0)
+
+		if unchanged {
+			replacements[id] = remaining
+		}
+
+		switch {
+		case unchanged && !args.matchesExtracted(remaining):
+			args.PushErr(result.errs[id])
+		case result.errs[id] != nil:
+			args.PushErr(result.errs[id])
+		default:
+			args.PushErr(
+				fmt.Errorf(
+					"%w: '%s' changes the meaning of '%s' "+
+						"after extraction began",
+					ErrLateFlag,
+					spec,
+					snap.args[i],
+				),
+			)
+		}
+	}
+
+	if len(replacements) > 0 {
+		args.replaceTokens(replacements)
+	}
+}
+
+// withoutArgs returns the tokens remaining once a token matching each of the
+// arguments is removed.
+func withoutArgs(tokens []token, list []string) []token {
+	remaining := slices.Clone(tokens)
+
+	for _, arg := range list {
+		idx := slices.IndexFunc(remaining, func(tok token) bool {
+			return tok.arg == arg
+		})
+		if idx >= 0 {
+			remaining = slices.Delete(remaining, idx, idx+1)
+		}
+	}
+
+	return remaining
+}
+
+// withoutTokens returns the tokens remaining once each of the removed tokens
+// is removed.  False is returned if any removed token is missing.
+func withoutTokens(tokens, removed []token) ([]token, bool) {
+	remaining := slices.Clone(tokens)
+
+	for _, tok := range removed {
+		idx := slices.Index(remaining, tok)
+		if idx < 0 {
+			return nil, false
+		}
+
+		remaining = slices.Delete(remaining, idx, idx+1)
+	}
+
+	return remaining, true
+}
+
+// matchesExtracted returns true if any of the flag or positional tokens
+// would be matched by a flag already extracted.
+func (args *Args) matchesExtracted(tokens []token) bool {
+	for _, tok := range tokens {
+		if tok.role != roleArg {
+			continue
+		}
+
+		for _, flag := range args.extracted {
+			_, attached := flag.argValue(tok.arg)
+			attached = attached && args.flags[flag.names()[0]] != noValue
+
+			if attached || flag.argIs(tok.arg) || flag.argIsNegated(tok.arg) {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// replaceTokens replaces the remaining arguments derived from each origin
+// with the tokens provided for it.
+func (args *Args) replaceTokens(replacements map[int][]token) {
+	var (
+		list    []string
+		origins []origin
+	)
+
+	replaced := make(map[int]bool)
+
+	for i, from := range args.origins {
+		tokens, ok := replacements[from.id]
+
+		switch {
+		case !ok:
+			list = append(list, args.args[i])
+			origins = append(origins, from)
+		case !replaced[from.id]:
+			replaced[from.id] = true
+
+			for _, tok := range tokens {
+				list = append(list, tok.arg)
+				origins = append(origins, from)
+			}
+		}
+	}
+
+	args.setArgs(list, origins)
+}
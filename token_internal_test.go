@@ -0,0 +1,110 @@
+/*
+   Szerszam argument library: szargs.
+   Copyright (C) 2024-2025  Leslie Dancsecs
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU General Public License for more details.
+
+   You should have received a copy of the GNU General Public License
+   along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package szargs
+
+import (
+	"testing"
+
+	"github.com/dancsecs/sztestlog"
+)
+
+func TestSzargs_Tokenize(t *testing.T) {
+	chk := sztestlog.CaptureNothing(t)
+	defer chk.Release()
+
+	args := New("description", []string{
+		"programName",
+		"-ab",
+		"--pattern", "-ab",
+		"-bn", "-ab",
+		"file",
+		"--",
+		"-ab",
+	})
+	args.registerFlag("[-a]", noValue)
+	args.registerFlag("[-b]", noValue)
+	args.registerFlag("[-n num]", singleValue)
+	args.registerFlag("[--pattern pattern]", singleValue)
+
+	args.tokenize()
+
+	chk.Int(args.scanLimit(), 8)
+
+	chk.StrSlice(
+		args.Args(),
+		[]string{
+			"-a", "-b",
+			"--pattern", "-ab",
+			"-b", "-n", "-ab",
+			"file",
+			"--",
+			"-ab",
+		},
+	)
+}
+
+func TestSzargs_Tokenize_ValueIsEndOfOptions(t *testing.T) {
+	chk := sztestlog.CaptureNothing(t)
+	defer chk.Release()
+
+	args := New("description", []string{
+		"programName",
+		"-n", "--",
+		"file",
+		"--",
+		"-n",
+	})
+	args.registerFlag("[-n num]", singleValue)
+
+	args.tokenize()
+
+	chk.Int(args.scanLimit(), 3)
+}
+
+func TestSzargs_Find(t *testing.T) {
+	chk := sztestlog.CaptureNothing(t)
+	defer chk.Release()
+
+	reg := registry{"-n": singleValue, "--name": singleValue, "-v": noValue}
+	list := []string{"-n", "-v", "-v", "--name", "-v", "--name=-v", "-v"}
+
+	found := argFlag("-v").find(list, noValue, reg)
+
+	chk.Int(len(found), 2)
+	chk.Int(found[0].start, 2)
+	chk.Int(found[1].start, 6)
+	chk.StrSlice(
+		removeOccurrences(list, found),
+		[]string{"-n", "-v", "--name", "-v", "--name=-v"},
+	)
+
+	found = argFlag("[-n | --name name]").find(list, singleValue, reg)
+
+	chk.Int(len(found), 3)
+	chk.Str(found[0].value, "-v")
+	chk.Str(found[1].value, "-v")
+	chk.Str(found[2].value, "-v")
+	chk.StrSlice(
+		removeOccurrences(list, found),
+		[]string{"-v", "-v"},
+	)
+
+	// Without a registry every matching argument is found.
+	chk.Int(len(argFlag("-v").find(list, noValue, nil)), 4)
+}
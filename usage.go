@@ -61,8 +61,19 @@ const spacePlaceholder = "\u001f"
 
 // RegisterUsage registers a new flag and its description if and only if the
 // flag has not been already  registered.
+//
+// Flags are also registered with the argument tokenizer.  A flag is
+// considered to take a value if its specification names one (IE:
+// "[-n | --num numOfLines]").  Results are only independent of the order
+// arguments are extracted in for flags registered before extraction begins.
+// A flag first registered by its own extraction that changes the meaning of
+// an argument already extracted registers an ErrLateFlag error instead (IE:
+// "-v" counted before "-n" takes it as its value).  Flag recognition in other
+// dialects, abbreviations, aliases and POSIXLY_CORRECT mode depend on the
+// flags registered in the same way.
 func (args *Args) RegisterUsage(item, desc string) {
 	if !args.usageDefined[item] {
+		args.registerSpec(item)
 		args.usageHeader += " " +
 			strings.ReplaceAll(item, " ", spacePlaceholder)
 		args.usageBody += "\n" + item + "\n" +
@@ -137,7 +148,8 @@ func (args *Args) Usage(lineWidth int) string {
 		header[1:]+
 			"\n\n"+
 			reflowLine("", args.programDesc, args.lineWidth)+"\n\n"+
-			reflowLines("    ", args.usageBody, args.lineWidth)+"\n",
+			reflowLines("    ", args.usageBody, args.lineWidth)+
+			args.aliasUsage()+"\n",
 		"\n",
 	)
 }
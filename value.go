@@ -29,8 +29,7 @@ package szargs
 // found.
 func (args *Args) ValueString(flag, desc string) (string, bool) {
 	args.RegisterUsage(flag, desc)
-	result, found, newArgs, err := argFlag(flag).value(args.args)
-	args.args = newArgs
+	result, _, found, err := args.scanValue(flag)
 	args.PushErr(err)
 
 	return result, found
@@ -47,28 +46,7 @@ func (args *Args) ValueString(flag, desc string) (string, bool) {
 // Returns the parsed value and a boolean indicating whether the flag was
 // found.
 func (args *Args) ValueFloat64(flag, desc string) (float64, bool) {
-	var (
-		arg    string
-		found  bool
-		result float64
-		err    error
-	)
-
-	args.RegisterUsage(flag, desc)
-
-	arg, found, newArgs, err := argFlag(flag).value(args.args)
-
-	if err == nil && found {
-		result, err = parseFloat64(flag, arg)
-		if err != nil {
-			found = false
-		}
-	}
-
-	args.args = newArgs
-	args.PushErr(err)
-
-	return result, found
+	return Value(args, ParseFloat64, flag, desc)
 }
 
 // ValueFloat32 scans for a specific flagged argument and parses its value as
@@ -82,28 +60,7 @@ func (args *Args) ValueFloat64(flag, desc string) (float64, bool) {
 // Returns the parsed value and a boolean indicating whether the flag was
 // found.
 func (args *Args) ValueFloat32(flag, desc string) (float32, bool) {
-	var (
-		arg    string
-		found  bool
-		result float32
-		err    error
-	)
-
-	args.RegisterUsage(flag, desc)
-
-	arg, found, newArgs, err := argFlag(flag).value(args.args)
-
-	if err == nil && found {
-		result, err = parseFloat32(flag, arg)
-		if err != nil {
-			found = false
-		}
-	}
-
-	args.args = newArgs
-	args.PushErr(err)
-
-	return result, found
+	return Value(args, ParseFloat32, flag, desc)
 }
 
 // ValueInt64 scans for a specific flagged argument and parses its value as a
@@ -117,28 +74,7 @@ func (args *Args) ValueFloat32(flag, desc string) (float32, bool) {
 // Returns the parsed value and a boolean indicating whether the flag was
 // found.
 func (args *Args) ValueInt64(flag, desc string) (int64, bool) {
-	var (
-		arg    string
-		found  bool
-		result int64
-		err    error
-	)
-
-	args.RegisterUsage(flag, desc)
-
-	arg, found, newArgs, err := argFlag(flag).value(args.args)
-
-	if err == nil && found {
-		result, err = parseInt64(flag, arg)
-		if err != nil {
-			found = false
-		}
-	}
-
-	args.args = newArgs
-	args.PushErr(err)
-
-	return result, found
+	return Value(args, ParseInt64, flag, desc)
 }
 
 // ValueInt32 scans for a specific flagged argument and parses its value as a
@@ -152,28 +88,7 @@ func (args *Args) ValueInt64(flag, desc string) (int64, bool) {
 // Returns the parsed value and a boolean indicating whether the flag was
 // found.
 func (args *Args) ValueInt32(flag, desc string) (int32, bool) {
-	var (
-		arg    string
-		found  bool
-		result int32
-		err    error
-	)
-
-	args.RegisterUsage(flag, desc)
-
-	arg, found, newArgs, err := argFlag(flag).value(args.args)
-
-	if err == nil && found {
-		result, err = parseInt32(flag, arg)
-		if err != nil {
-			found = false
-		}
-	}
-
-	args.args = newArgs
-	args.PushErr(err)
-
-	return result, found
+	return Value(args, ParseInt32, flag, desc)
 }
 
 // ValueInt16 scans for a specific flagged argument and parses its value as a
@@ -187,28 +102,7 @@ func (args *Args) ValueInt32(flag, desc string) (int32, bool) {
 // Returns the parsed value and a boolean indicating whether the flag was
 // found.
 func (args *Args) ValueInt16(flag, desc string) (int16, bool) {
-	var (
-		arg    string
-		found  bool
-		result int16
-		err    error
-	)
-
-	args.RegisterUsage(flag, desc)
-
-	arg, found, newArgs, err := argFlag(flag).value(args.args)
-
-	if err == nil && found {
-		result, err = parseInt16(flag, arg)
-		if err != nil {
-			found = false
-		}
-	}
-
-	args.args = newArgs
-	args.PushErr(err)
-
-	return result, found
+	return Value(args, ParseInt16, flag, desc)
 }
 
 // ValueInt8 scans for a specific flagged argument and parses its value as a
@@ -222,28 +116,7 @@ func (args *Args) ValueInt16(flag, desc string) (int16, bool) {
 // Returns the parsed value and a boolean indicating whether the flag was
 // found.
 func (args *Args) ValueInt8(flag, desc string) (int8, bool) {
-	var (
-		arg    string
-		found  bool
-		result int8
-		err    error
-	)
-
-	args.RegisterUsage(flag, desc)
-
-	arg, found, newArgs, err := argFlag(flag).value(args.args)
-
-	if err == nil && found {
-		result, err = parseInt8(flag, arg)
-		if err != nil {
-			found = false
-		}
-	}
-
-	args.args = newArgs
-	args.PushErr(err)
-
-	return result, found
+	return Value(args, ParseInt8, flag, desc)
 }
 
 // ValueInt scans for a specific flagged argument and parses its value as a
@@ -257,28 +130,7 @@ func (args *Args) ValueInt8(flag, desc string) (int8, bool) {
 // Returns the parsed value and a boolean indicating whether the flag was
 // found.
 func (args *Args) ValueInt(flag, desc string) (int, bool) {
-	var (
-		arg    string
-		found  bool
-		result int
-		err    error
-	)
-
-	args.RegisterUsage(flag, desc)
-
-	arg, found, newArgs, err := argFlag(flag).value(args.args)
-
-	if err == nil && found {
-		result, err = parseInt(flag, arg)
-		if err != nil {
-			found = false
-		}
-	}
-
-	args.args = newArgs
-	args.PushErr(err)
-
-	return result, found
+	return Value(args, ParseInt, flag, desc)
 }
 
 // ValueUint64 scans for a specific flagged argument and parses its value as
@@ -292,28 +144,7 @@ func (args *Args) ValueInt(flag, desc string) (int, bool) {
 // Returns the parsed value and a boolean indicating whether the flag was
 // found.
 func (args *Args) ValueUint64(flag, desc string) (uint64, bool) {
-	var (
-		arg    string
-		found  bool
-		result uint64
-		err    error
-	)
-
-	args.RegisterUsage(flag, desc)
-
-	arg, found, newArgs, err := argFlag(flag).value(args.args)
-
-	if err == nil && found {
-		result, err = parseUint64(flag, arg)
-		if err != nil {
-			found = false
-		}
-	}
-
-	args.args = newArgs
-	args.PushErr(err)
-
-	return result, found
+	return Value(args, ParseUint64, flag, desc)
 }
 
 // ValueUint32 scans for a specific flagged argument and parses its value as
@@ -327,28 +158,7 @@ func (args *Args) ValueUint64(flag, desc string) (uint64, bool) {
 // Returns the parsed value and a boolean indicating whether the flag was
 // found.
 func (args *Args) ValueUint32(flag, desc string) (uint32, bool) {
-	var (
-		arg    string
-		found  bool
-		result uint32
-		err    error
-	)
-
-	args.RegisterUsage(flag, desc)
-
-	arg, found, newArgs, err := argFlag(flag).value(args.args)
-
-	if err == nil && found {
-		result, err = parseUint32(flag, arg)
-		if err != nil {
-			found = false
-		}
-	}
-
-	args.args = newArgs
-	args.PushErr(err)
-
-	return result, found
+	return Value(args, ParseUint32, flag, desc)
 }
 
 // ValueUint16 scans for a specific flagged argument and parses its value as
@@ -362,28 +172,7 @@ func (args *Args) ValueUint32(flag, desc string) (uint32, bool) {
 // Returns the parsed value and a boolean indicating whether the flag was
 // found.
 func (args *Args) ValueUint16(flag, desc string) (uint16, bool) {
-	var (
-		arg    string
-		found  bool
-		result uint16
-		err    error
-	)
-
-	args.RegisterUsage(flag, desc)
-
-	arg, found, newArgs, err := argFlag(flag).value(args.args)
-
-	if err == nil && found {
-		result, err = parseUint16(flag, arg)
-		if err != nil {
-			found = false
-		}
-	}
-
-	args.args = newArgs
-	args.PushErr(err)
-
-	return result, found
+	return Value(args, ParseUint16, flag, desc)
 }
 
 // ValueUint8 scans for a specific flagged argument and parses its value as an
@@ -397,28 +186,7 @@ func (args *Args) ValueUint16(flag, desc string) (uint16, bool) {
 // Returns the parsed value and a boolean indicating whether the flag was
 // found.
 func (args *Args) ValueUint8(flag, desc string) (uint8, bool) {
-	var (
-		arg    string
-		found  bool
-		result uint8
-		err    error
-	)
-
-	args.RegisterUsage(flag, desc)
-
-	arg, found, newArgs, err := argFlag(flag).value(args.args)
-
-	if err == nil && found {
-		result, err = parseUint8(flag, arg)
-		if err != nil {
-			found = false
-		}
-	}
-
-	args.args = newArgs
-	args.PushErr(err)
-
-	return result, found
+	return Value(args, ParseUint8, flag, desc)
 }
 
 // ValueUint scans for a specific flagged argument and parses its value as an
@@ -432,28 +200,7 @@ func (args *Args) ValueUint8(flag, desc string) (uint8, bool) {
 // Returns the parsed value and a boolean indicating whether the flag was
 // found.
 func (args *Args) ValueUint(flag, desc string) (uint, bool) {
-	var (
-		arg    string
-		found  bool
-		result uint
-		err    error
-	)
-
-	args.RegisterUsage(flag, desc)
-
-	arg, found, newArgs, err := argFlag(flag).value(args.args)
-
-	if err == nil && found {
-		result, err = parseUint(flag, arg)
-		if err != nil {
-			found = false
-		}
-	}
-
-	args.args = newArgs
-	args.PushErr(err)
-
-	return result, found
+	return Value(args, ParseUint, flag, desc)
 }
 
 // ValueOption scans for a specific flagged argument (e.g., "--mode value")
@@ -468,26 +215,14 @@ func (args *Args) ValueUint(flag, desc string) (uint, bool) {
 func (args *Args) ValueOption(
 	flag string, validOptions []string, desc string,
 ) (string, bool) {
-	var (
-		arg    string
-		found  bool
-		result string
-		err    error
-	)
-
-	args.RegisterUsage(flag, desc)
-
-	arg, found, newArgs, err := argFlag(flag).value(args.args)
-
-	if err == nil && found {
-		result, err = parseOption(flag, arg, validOptions)
-		if err != nil {
-			found = false
-		}
-	}
-
-	args.args = newArgs
-	args.PushErr(err)
+	return args.ValueOptionMatch(flag, validOptions, OptionExact, desc)
+}
 
-	return result, found
+// ValueOptionMatch is ValueOption matching the validOptions as selected by the
+// match modes (IE: OptionIgnoreCase | OptionPrefix).  The canonical option
+// matched is returned.
+func (args *Args) ValueOptionMatch(
+	flag string, validOptions []string, match OptionMatch, desc string,
+) (string, bool) {
+	return Value(args, OptionParser(validOptions, match), flag, desc)
 }
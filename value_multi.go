@@ -0,0 +1,239 @@
+/*
+   Szerszam argument library: szargs.
+   Copyright (C) 2024-2025  Leslie Dancsecs
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU General Public License for more details.
+
+   You should have received a copy of the GNU General Public License
+   along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package szargs
+
+// ValueStrings scans for a specific flagged argument followed by exactly n
+// values (e.g., "--rename old new") and captures them as a slice of strings.
+// The flag and its values are removed from the argument list.
+//
+// If the flag appears more than once, or if fewer than n values follow it, an
+// error is registered.
+//
+// Returns the n captured values or nil if the flag was not found.
+func (args *Args) ValueStrings(flag string, n int, desc string) []string {
+	args.RegisterUsage(flag, desc)
+	result, _, err := args.scanTuple(flag, n)
+	args.PushErr(err)
+
+	return result
+}
+
+// ValueFloat64s scans for a specific flagged argument followed by exactly n
+// values (e.g., "--point 3 4") and parses each as a 64 bit floating point
+// number. The flag and its values are removed from the argument list.
+//
+// If the flag appears more than once, if fewer than n values follow it, or if
+// a value has invalid syntax or is out of range for a float64, an error is
+// registered.
+//
+// Returns the n parsed values or nil if the flag was not found.
+func (args *Args) ValueFloat64s(
+	flag string, n int, desc string,
+) []float64 {
+	return ValueN(args, ParseFloat64, flag, n, desc)
+}
+
+// ValueFloat32s scans for a specific flagged argument followed by exactly n
+// values (e.g., "--point 3 4") and parses each as a 32 bit floating point
+// number. The flag and its values are removed from the argument list.
+//
+// If the flag appears more than once, if fewer than n values follow it, or if
+// a value has invalid syntax or is out of range for a float32, an error is
+// registered.
+//
+// Returns the n parsed values or nil if the flag was not found.
+func (args *Args) ValueFloat32s(
+	flag string, n int, desc string,
+) []float32 {
+	return ValueN(args, ParseFloat32, flag, n, desc)
+}
+
+// ValueInt64s scans for a specific flagged argument followed by exactly n
+// values (e.g., "--point 3 4") and parses each as a signed 64 bit integer. The
+// flag and its values are removed from the argument list.
+//
+// If the flag appears more than once, if fewer than n values follow it, or if
+// a value has invalid syntax or is out of range for an int64, an error is
+// registered.
+//
+// Returns the n parsed values or nil if the flag was not found.
+func (args *Args) ValueInt64s(
+	flag string, n int, desc string,
+) []int64 {
+	return ValueN(args, ParseInt64, flag, n, desc)
+}
+
+// ValueInt32s scans for a specific flagged argument followed by exactly n
+// values (e.g., "--point 3 4") and parses each as a signed 32 bit integer. The
+// flag and its values are removed from the argument list.
+//
+// If the flag appears more than once, if fewer than n values follow it, or if
+// a value has invalid syntax or is out of range for an int32, an error is
+// registered.
+//
+// Returns the n parsed values or nil if the flag was not found.
+func (args *Args) ValueInt32s(
+	flag string, n int, desc string,
+) []int32 {
+	return ValueN(args, ParseInt32, flag, n, desc)
+}
+
+// ValueInt16s scans for a specific flagged argument followed by exactly n
+// values (e.g., "--point 3 4") and parses each as a signed 16 bit integer. The
+// flag and its values are removed from the argument list.
+//
+// If the flag appears more than once, if fewer than n values follow it, or if
+// a value has invalid syntax or is out of range for an int16, an error is
+// registered.
+//
+// Returns the n parsed values or nil if the flag was not found.
+func (args *Args) ValueInt16s(
+	flag string, n int, desc string,
+) []int16 {
+	return ValueN(args, ParseInt16, flag, n, desc)
+}
+
+// ValueInt8s scans for a specific flagged argument followed by exactly n
+// values (e.g., "--point 3 4") and parses each as a signed 8 bit integer. The
+// flag and its values are removed from the argument list.
+//
+// If the flag appears more than once, if fewer than n values follow it, or if
+// a value has invalid syntax or is out of range for an int8, an error is
+// registered.
+//
+// Returns the n parsed values or nil if the flag was not found.
+func (args *Args) ValueInt8s(
+	flag string, n int, desc string,
+) []int8 {
+	return ValueN(args, ParseInt8, flag, n, desc)
+}
+
+// ValueInts scans for a specific flagged argument followed by exactly n values
+// (e.g., "--point 3 4") and parses each as a signed integer. The flag and its
+// values are removed from the argument list.
+//
+// If the flag appears more than once, if fewer than n values follow it, or if
+// a value has invalid syntax or is out of range for an int, an error is
+// registered.
+//
+// Returns the n parsed values or nil if the flag was not found.
+func (args *Args) ValueInts(
+	flag string, n int, desc string,
+) []int {
+	return ValueN(args, ParseInt, flag, n, desc)
+}
+
+// ValueUint64s scans for a specific flagged argument followed by exactly n
+// values (e.g., "--point 3 4") and parses each as an unsigned 64 bit integer.
+// The flag and its values are removed from the argument list.
+//
+// If the flag appears more than once, if fewer than n values follow it, or if
+// a value has invalid syntax or is out of range for a uint64, an error is
+// registered.
+//
+// Returns the n parsed values or nil if the flag was not found.
+func (args *Args) ValueUint64s(
+	flag string, n int, desc string,
+) []uint64 {
+	return ValueN(args, ParseUint64, flag, n, desc)
+}
+
+// ValueUint32s scans for a specific flagged argument followed by exactly n
+// values (e.g., "--point 3 4") and parses each as an unsigned 32 bit integer.
+// The flag and its values are removed from the argument list.
+//
+// If the flag appears more than once, if fewer than n values follow it, or if
+// a value has invalid syntax or is out of range for a uint32, an error is
+// registered.
+//
+// Returns the n parsed values or nil if the flag was not found.
+func (args *Args) ValueUint32s(
+	flag string, n int, desc string,
+) []uint32 {
+	return ValueN(args, ParseUint32, flag, n, desc)
+}
+
+// ValueUint16s scans for a specific flagged argument followed by exactly n
+// values (e.g., "--point 3 4") and parses each as an unsigned 16 bit integer.
+// The flag and its values are removed from the argument list.
+//
+// If the flag appears more than once, if fewer than n values follow it, or if
+// a value has invalid syntax or is out of range for a uint16, an error is
+// registered.
+//
+// Returns the n parsed values or nil if the flag was not found.
+func (args *Args) ValueUint16s(
+	flag string, n int, desc string,
+) []uint16 {
+	return ValueN(args, ParseUint16, flag, n, desc)
+}
+
+// ValueUint8s scans for a specific flagged argument followed by exactly n
+// values (e.g., "--point 3 4") and parses each as an unsigned 8 bit integer.
+// The flag and its values are removed from the argument list.
+//
+// If the flag appears more than once, if fewer than n values follow it, or if
+// a value has invalid syntax or is out of range for a uint8, an error is
+// registered.
+//
+// Returns the n parsed values or nil if the flag was not found.
+func (args *Args) ValueUint8s(
+	flag string, n int, desc string,
+) []uint8 {
+	return ValueN(args, ParseUint8, flag, n, desc)
+}
+
+// ValueUints scans for a specific flagged argument followed by exactly n
+// values (e.g., "--point 3 4") and parses each as an unsigned integer. The
+// flag and its values are removed from the argument list.
+//
+// If the flag appears more than once, if fewer than n values follow it, or if
+// a value has invalid syntax or is out of range for a uint, an error is
+// registered.
+//
+// Returns the n parsed values or nil if the flag was not found.
+func (args *Args) ValueUints(
+	flag string, n int, desc string,
+) []uint {
+	return ValueN(args, ParseUint, flag, n, desc)
+}
+
+// ValueOptions scans for a specific flagged argument followed by exactly n
+// values (e.g., "--swap red blue") and captures them as a slice of strings.
+// The flag and its values are removed from the argument list.
+//
+// If the flag appears more than once, if fewer than n values follow it, or if
+// a value is not found in the provided list of validOptions, an error is
+// registered.
+//
+// Returns the n captured values or nil if the flag was not found.
+func (args *Args) ValueOptions(
+	flag string, n int, validOptions []string, desc string,
+) []string {
+	return args.ValueOptionsMatch(flag, n, validOptions, OptionExact, desc)
+}
+
+// ValueOptionsMatch is ValueOptions matching the validOptions as selected by
+// the match modes (IE: OptionIgnoreCase | OptionPrefix).  The canonical option
+// matched is returned.
+func (args *Args) ValueOptionsMatch(
+	flag string, n int, validOptions []string, match OptionMatch, desc string,
+) []string {
+	return ValueN(args, OptionParser(validOptions, match), flag, n, desc)
+}
@@ -0,0 +1,632 @@
+/*
+   Szerszam argument library: szargs.
+   Copyright (C) 2024-2025  Leslie Dancsecs
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU General Public License for more details.
+
+   You should have received a copy of the GNU General Public License
+   along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package szargs_test
+
+import (
+	"testing"
+
+	"github.com/dancsecs/szargs"
+	"github.com/dancsecs/sztestlog"
+)
+
+func TestSzargs_ValueStrings_Missing(t *testing.T) {
+	chk := sztestlog.CaptureNothing(t)
+	defer chk.Release()
+
+	args := szargs.New("program description", []string{
+		"programName",
+		"file",
+	})
+
+	result := args.ValueStrings("[--rename old new]", 2, "rename a file")
+
+	chk.NoErr(args.Err())
+	chk.StrSlice(result, nil)
+	chk.StrSlice(args.Args(), []string{"file"})
+}
+
+func TestSzargs_ValueStrings(t *testing.T) {
+	chk := sztestlog.CaptureNothing(t)
+	defer chk.Release()
+
+	args := szargs.New("program description", []string{
+		"programName",
+		"--rename",
+		"old",
+		"new",
+		"file",
+	})
+
+	result := args.ValueStrings("[--rename old new]", 2, "rename a file")
+
+	chk.NoErr(args.Err())
+	chk.StrSlice(result, []string{"old", "new"})
+	chk.StrSlice(args.Args(), []string{"file"})
+
+	args = szargs.New("program description", []string{
+		"programName",
+		"--rename=old",
+		"new",
+		"file",
+	})
+
+	result = args.ValueStrings("[--rename old new]", 2, "rename a file")
+
+	chk.NoErr(args.Err())
+	chk.StrSlice(result, []string{"old", "new"})
+	chk.StrSlice(args.Args(), []string{"file"})
+}
+
+func TestSzargs_ValueStrings_TooFew(t *testing.T) {
+	chk := sztestlog.CaptureNothing(t)
+	defer chk.Release()
+
+	args := szargs.New("program description", []string{
+		"programName",
+		"--rename",
+		"old",
+	})
+
+	result := args.ValueStrings("[--rename old new]", 2, "rename a file")
+
+	chk.Err(
+		args.Err(),
+		chk.ErrChain(
+			szargs.ErrMissing,
+			"'[--rename old new]' expects 2 values but found 1",
+		),
+	)
+	chk.StrSlice(result, nil)
+	chk.StrSlice(args.Args(), nil)
+}
+
+func TestSzargs_ValueStrings_Ambiguous(t *testing.T) {
+	chk := sztestlog.CaptureNothing(t)
+	defer chk.Release()
+
+	args := szargs.New("program description", []string{
+		"programName",
+		"--rename", "a", "b",
+		"--rename", "c", "d",
+	})
+
+	result := args.ValueStrings("[--rename old new]", 2, "rename a file")
+
+	chk.Err(
+		args.Err(),
+		chk.ErrChain(
+			szargs.ErrAmbiguous,
+			"'[--rename old new]' for [c d] already set to: [a b]",
+		),
+	)
+	chk.StrSlice(result, nil)
+	chk.StrSlice(args.Args(), nil)
+}
+
+func TestSzargs_ValueStrings_Registered(t *testing.T) {
+	chk := sztestlog.CaptureNothing(t)
+	defer chk.Release()
+
+	args := szargs.New("program description", []string{
+		"programName",
+		"--rect", "-1", "-2", "3", "4",
+		"-v",
+	})
+
+	// The arity is taken from the number of value names in the usage.
+	args.RegisterUsage("[--rect x y w h]", "the rectangle")
+
+	chk.True(args.Is("-v", "verbose"))
+
+	result := args.ValueInts("[--rect x y w h]", 4, "the rectangle")
+
+	chk.NoErr(args.Err())
+	chk.IntSlice(result, []int{-1, -2, 3, 4})
+	chk.StrSlice(args.Args(), nil)
+}
+
+func TestSzargs_ValueInts(t *testing.T) {
+	chk := sztestlog.CaptureNothing(t)
+	defer chk.Release()
+
+	args := szargs.New("program description", []string{
+		"programName",
+		"--point",
+		"3",
+		"4",
+	})
+
+	result := args.ValueInts("[--point x y]", 2, "a point")
+
+	chk.NoErr(args.Err())
+	chk.IntSlice(result, []int{3, 4})
+	chk.StrSlice(args.Args(), nil)
+}
+
+func TestSzargs_ValueInts_Invalid(t *testing.T) {
+	chk := sztestlog.CaptureNothing(t)
+	defer chk.Release()
+
+	args := szargs.New("program description", []string{
+		"programName",
+		"--point",
+		"3",
+		"four",
+	})
+
+	result := args.ValueInts("[--point x y]", 2, "a point")
+
+	chk.Err(
+		args.Err(),
+		chk.ErrChain(
+			szargs.ErrInvalidInt,
+			szargs.ErrSyntax,
+			"[--point x y]",
+			"'four'",
+		),
+	)
+	chk.IntSlice(result, nil)
+	chk.StrSlice(args.Args(), nil)
+}
+
+func TestSzargs_ValueFloat64s(t *testing.T) {
+	chk := sztestlog.CaptureNothing(t)
+	defer chk.Release()
+
+	args := szargs.New("program description", []string{
+		"programName",
+		"-p1.5",
+		"2.5",
+	})
+
+	result := args.ValueFloat64s("[-p x y]", 2, "a point")
+
+	chk.NoErr(args.Err())
+	chk.Float64Slice(result, []float64{1.5, 2.5}, 0)
+	chk.StrSlice(args.Args(), nil)
+}
+
+func TestSzargs_ValueOptions(t *testing.T) {
+	chk := sztestlog.CaptureNothing(t)
+	defer chk.Release()
+
+	colors := []string{"red", "green", "blue"}
+
+	args := szargs.New("program description", []string{
+		"programName",
+		"--swap",
+		"red",
+		"blue",
+	})
+
+	result := args.ValueOptions("[--swap a b]", 2, colors, "swap colors")
+
+	chk.NoErr(args.Err())
+	chk.StrSlice(result, []string{"red", "blue"})
+	chk.StrSlice(args.Args(), nil)
+
+	args = szargs.New("program description", []string{
+		"programName",
+		"--swap",
+		"red",
+		"pink",
+	})
+
+	result = args.ValueOptions("[--swap a b]", 2, colors, "swap colors")
+
+	chk.Err(
+		args.Err(),
+		chk.ErrChain(
+			szargs.ErrInvalidOption,
+			"'pink' ([--swap a b] must be one of [red green blue])",
+		),
+	)
+	chk.StrSlice(result, nil)
+	chk.StrSlice(args.Args(), nil)
+}
+
+func TestSzargs_ValueFloat32s(t *testing.T) {
+	chk := sztestlog.CaptureNothing(t)
+	defer chk.Release()
+
+	args := szargs.New("program description", []string{
+		"programName",
+		"--point",
+		"3",
+		"4",
+	})
+
+	result := args.ValueFloat32s("[--point x y]", 2, "a point")
+
+	chk.NoErr(args.Err())
+	chk.Float32Slice(result, []float32{3, 4}, 0)
+	chk.StrSlice(args.Args(), nil)
+
+	args = szargs.New("program description", []string{
+		"programName",
+		"--point",
+		"3",
+		"four",
+	})
+
+	result = args.ValueFloat32s("[--point x y]", 2, "a point")
+
+	chk.Err(
+		args.Err(),
+		chk.ErrChain(
+			szargs.ErrInvalidFloat32,
+			szargs.ErrSyntax,
+			"[--point x y]",
+			"'four'",
+		),
+	)
+	chk.Float32Slice(result, nil, 0)
+	chk.StrSlice(args.Args(), nil)
+}
+
+func TestSzargs_ValueInt64s(t *testing.T) {
+	chk := sztestlog.CaptureNothing(t)
+	defer chk.Release()
+
+	args := szargs.New("program description", []string{
+		"programName",
+		"--point",
+		"3",
+		"4",
+	})
+
+	result := args.ValueInt64s("[--point x y]", 2, "a point")
+
+	chk.NoErr(args.Err())
+	chk.Int64Slice(result, []int64{3, 4})
+	chk.StrSlice(args.Args(), nil)
+
+	args = szargs.New("program description", []string{
+		"programName",
+		"--point",
+		"3",
+		"four",
+	})
+
+	result = args.ValueInt64s("[--point x y]", 2, "a point")
+
+	chk.Err(
+		args.Err(),
+		chk.ErrChain(
+			szargs.ErrInvalidInt64,
+			szargs.ErrSyntax,
+			"[--point x y]",
+			"'four'",
+		),
+	)
+	chk.Int64Slice(result, nil)
+	chk.StrSlice(args.Args(), nil)
+}
+
+func TestSzargs_ValueInt32s(t *testing.T) {
+	chk := sztestlog.CaptureNothing(t)
+	defer chk.Release()
+
+	args := szargs.New("program description", []string{
+		"programName",
+		"--point",
+		"3",
+		"4",
+	})
+
+	result := args.ValueInt32s("[--point x y]", 2, "a point")
+
+	chk.NoErr(args.Err())
+	chk.Int32Slice(result, []int32{3, 4})
+	chk.StrSlice(args.Args(), nil)
+
+	args = szargs.New("program description", []string{
+		"programName",
+		"--point",
+		"3",
+		"four",
+	})
+
+	result = args.ValueInt32s("[--point x y]", 2, "a point")
+
+	chk.Err(
+		args.Err(),
+		chk.ErrChain(
+			szargs.ErrInvalidInt32,
+			szargs.ErrSyntax,
+			"[--point x y]",
+			"'four'",
+		),
+	)
+	chk.Int32Slice(result, nil)
+	chk.StrSlice(args.Args(), nil)
+}
+
+func TestSzargs_ValueInt16s(t *testing.T) {
+	chk := sztestlog.CaptureNothing(t)
+	defer chk.Release()
+
+	args := szargs.New("program description", []string{
+		"programName",
+		"--point",
+		"3",
+		"4",
+	})
+
+	result := args.ValueInt16s("[--point x y]", 2, "a point")
+
+	chk.NoErr(args.Err())
+	chk.Int16Slice(result, []int16{3, 4})
+	chk.StrSlice(args.Args(), nil)
+
+	args = szargs.New("program description", []string{
+		"programName",
+		"--point",
+		"3",
+		"four",
+	})
+
+	result = args.ValueInt16s("[--point x y]", 2, "a point")
+
+	chk.Err(
+		args.Err(),
+		chk.ErrChain(
+			szargs.ErrInvalidInt16,
+			szargs.ErrSyntax,
+			"[--point x y]",
+			"'four'",
+		),
+	)
+	chk.Int16Slice(result, nil)
+	chk.StrSlice(args.Args(), nil)
+}
+
+func TestSzargs_ValueInt8s(t *testing.T) {
+	chk := sztestlog.CaptureNothing(t)
+	defer chk.Release()
+
+	args := szargs.New("program description", []string{
+		"programName",
+		"--point",
+		"3",
+		"4",
+	})
+
+	result := args.ValueInt8s("[--point x y]", 2, "a point")
+
+	chk.NoErr(args.Err())
+	chk.Int8Slice(result, []int8{3, 4})
+	chk.StrSlice(args.Args(), nil)
+
+	args = szargs.New("program description", []string{
+		"programName",
+		"--point",
+		"3",
+		"four",
+	})
+
+	result = args.ValueInt8s("[--point x y]", 2, "a point")
+
+	chk.Err(
+		args.Err(),
+		chk.ErrChain(
+			szargs.ErrInvalidInt8,
+			szargs.ErrSyntax,
+			"[--point x y]",
+			"'four'",
+		),
+	)
+	chk.Int8Slice(result, nil)
+	chk.StrSlice(args.Args(), nil)
+}
+
+func TestSzargs_ValueUint64s(t *testing.T) {
+	chk := sztestlog.CaptureNothing(t)
+	defer chk.Release()
+
+	args := szargs.New("program description", []string{
+		"programName",
+		"--point",
+		"3",
+		"4",
+	})
+
+	result := args.ValueUint64s("[--point x y]", 2, "a point")
+
+	chk.NoErr(args.Err())
+	chk.Uint64Slice(result, []uint64{3, 4})
+	chk.StrSlice(args.Args(), nil)
+
+	args = szargs.New("program description", []string{
+		"programName",
+		"--point",
+		"3",
+		"four",
+	})
+
+	result = args.ValueUint64s("[--point x y]", 2, "a point")
+
+	chk.Err(
+		args.Err(),
+		chk.ErrChain(
+			szargs.ErrInvalidUint64,
+			szargs.ErrSyntax,
+			"[--point x y]",
+			"'four'",
+		),
+	)
+	chk.Uint64Slice(result, nil)
+	chk.StrSlice(args.Args(), nil)
+}
+
+func TestSzargs_ValueUint32s(t *testing.T) {
+	chk := sztestlog.CaptureNothing(t)
+	defer chk.Release()
+
+	args := szargs.New("program description", []string{
+		"programName",
+		"--point",
+		"3",
+		"4",
+	})
+
+	result := args.ValueUint32s("[--point x y]", 2, "a point")
+
+	chk.NoErr(args.Err())
+	chk.Uint32Slice(result, []uint32{3, 4})
+	chk.StrSlice(args.Args(), nil)
+
+	args = szargs.New("program description", []string{
+		"programName",
+		"--point",
+		"3",
+		"four",
+	})
+
+	result = args.ValueUint32s("[--point x y]", 2, "a point")
+
+	chk.Err(
+		args.Err(),
+		chk.ErrChain(
+			szargs.ErrInvalidUint32,
+			szargs.ErrSyntax,
+			"[--point x y]",
+			"'four'",
+		),
+	)
+	chk.Uint32Slice(result, nil)
+	chk.StrSlice(args.Args(), nil)
+}
+
+func TestSzargs_ValueUint16s(t *testing.T) {
+	chk := sztestlog.CaptureNothing(t)
+	defer chk.Release()
+
+	args := szargs.New("program description", []string{
+		"programName",
+		"--point",
+		"3",
+		"4",
+	})
+
+	result := args.ValueUint16s("[--point x y]", 2, "a point")
+
+	chk.NoErr(args.Err())
+	chk.Uint16Slice(result, []uint16{3, 4})
+	chk.StrSlice(args.Args(), nil)
+
+	args = szargs.New("program description", []string{
+		"programName",
+		"--point",
+		"3",
+		"four",
+	})
+
+	result = args.ValueUint16s("[--point x y]", 2, "a point")
+
+	chk.Err(
+		args.Err(),
+		chk.ErrChain(
+			szargs.ErrInvalidUint16,
+			szargs.ErrSyntax,
+			"[--point x y]",
+			"'four'",
+		),
+	)
+	chk.Uint16Slice(result, nil)
+	chk.StrSlice(args.Args(), nil)
+}
+
+func TestSzargs_ValueUint8s(t *testing.T) {
+	chk := sztestlog.CaptureNothing(t)
+	defer chk.Release()
+
+	args := szargs.New("program description", []string{
+		"programName",
+		"--point",
+		"3",
+		"4",
+	})
+
+	result := args.ValueUint8s("[--point x y]", 2, "a point")
+
+	chk.NoErr(args.Err())
+	chk.Uint8Slice(result, []uint8{3, 4})
+	chk.StrSlice(args.Args(), nil)
+
+	args = szargs.New("program description", []string{
+		"programName",
+		"--point",
+		"3",
+		"four",
+	})
+
+	result = args.ValueUint8s("[--point x y]", 2, "a point")
+
+	chk.Err(
+		args.Err(),
+		chk.ErrChain(
+			szargs.ErrInvalidUint8,
+			szargs.ErrSyntax,
+			"[--point x y]",
+			"'four'",
+		),
+	)
+	chk.Uint8Slice(result, nil)
+	chk.StrSlice(args.Args(), nil)
+}
+
+func TestSzargs_ValueUints(t *testing.T) {
+	chk := sztestlog.CaptureNothing(t)
+	defer chk.Release()
+
+	args := szargs.New("program description", []string{
+		"programName",
+		"--point",
+		"3",
+		"4",
+	})
+
+	result := args.ValueUints("[--point x y]", 2, "a point")
+
+	chk.NoErr(args.Err())
+	chk.UintSlice(result, []uint{3, 4})
+	chk.StrSlice(args.Args(), nil)
+
+	args = szargs.New("program description", []string{
+		"programName",
+		"--point",
+		"3",
+		"four",
+	})
+
+	result = args.ValueUints("[--point x y]", 2, "a point")
+
+	chk.Err(
+		args.Err(),
+		chk.ErrChain(
+			szargs.ErrInvalidUint,
+			szargs.ErrSyntax,
+			"[--point x y]",
+			"'four'",
+		),
+	)
+	chk.UintSlice(result, nil)
+	chk.StrSlice(args.Args(), nil)
+}
@@ -0,0 +1,264 @@
+/*
+   Szerszam argument library: szargs.
+   Copyright (C) 2024-2025  Leslie Dancsecs
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU General Public License for more details.
+
+   You should have received a copy of the GNU General Public License
+   along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package szargs
+
+// ValueOptionalString scans for a specific flagged argument whose value is
+// optional (e.g., "--color" or "--color=always") and captures any attached
+// value as a string. The flag is removed from the argument list. A following
+// argument is never absorbed as the value.
+//
+// If the flag appears more than once, an error is registered.
+//
+// Returns the attached value (or implied if no value was attached) and a
+// boolean indicating whether the flag was found.
+func (args *Args) ValueOptionalString(
+	flag, implied, desc string,
+) (string, bool) {
+	args.RegisterUsage(flag, desc)
+	result, _, hasValue, found, err := args.scanOptional(flag)
+	args.PushErr(err)
+
+	if found && !hasValue {
+		result = implied
+	}
+
+	return result, found
+}
+
+// ValueOptionalFloat64 scans for a specific flagged argument whose value is
+// optional (e.g., "--level" or "--level=3") and parses any attached value as a
+// 64 bit floating point number. The flag is removed from the argument list. A
+// following argument is never absorbed as the value.
+//
+// If the flag appears more than once, or if the attached value has invalid
+// syntax or is out of range for a float64, an error is registered.
+//
+// Returns the parsed value (or implied if no value was attached) and a boolean
+// indicating whether the flag was found.
+func (args *Args) ValueOptionalFloat64(
+	flag string, implied float64, desc string,
+) (float64, bool) {
+	return ValueOptional(args, ParseFloat64, flag, implied, desc)
+}
+
+// ValueOptionalFloat32 scans for a specific flagged argument whose value is
+// optional (e.g., "--level" or "--level=3") and parses any attached value as a
+// 32 bit floating point number. The flag is removed from the argument list. A
+// following argument is never absorbed as the value.
+//
+// If the flag appears more than once, or if the attached value has invalid
+// syntax or is out of range for a float32, an error is registered.
+//
+// Returns the parsed value (or implied if no value was attached) and a boolean
+// indicating whether the flag was found.
+func (args *Args) ValueOptionalFloat32(
+	flag string, implied float32, desc string,
+) (float32, bool) {
+	return ValueOptional(args, ParseFloat32, flag, implied, desc)
+}
+
+// ValueOptionalInt64 scans for a specific flagged argument whose value is
+// optional (e.g., "--level" or "--level=3") and parses any attached value as a
+// signed 64 bit integer. The flag is removed from the argument list. A
+// following argument is never absorbed as the value.
+//
+// If the flag appears more than once, or if the attached value has invalid
+// syntax or is out of range for an int64, an error is registered.
+//
+// Returns the parsed value (or implied if no value was attached) and a boolean
+// indicating whether the flag was found.
+func (args *Args) ValueOptionalInt64(
+	flag string, implied int64, desc string,
+) (int64, bool) {
+	return ValueOptional(args, ParseInt64, flag, implied, desc)
+}
+
+// ValueOptionalInt32 scans for a specific flagged argument whose value is
+// optional (e.g., "--level" or "--level=3") and parses any attached value as a
+// signed 32 bit integer. The flag is removed from the argument list. A
+// following argument is never absorbed as the value.
+//
+// If the flag appears more than once, or if the attached value has invalid
+// syntax or is out of range for an int32, an error is registered.
+//
+// Returns the parsed value (or implied if no value was attached) and a boolean
+// indicating whether the flag was found.
+func (args *Args) ValueOptionalInt32(
+	flag string, implied int32, desc string,
+) (int32, bool) {
+	return ValueOptional(args, ParseInt32, flag, implied, desc)
+}
+
+// ValueOptionalInt16 scans for a specific flagged argument whose value is
+// optional (e.g., "--level" or "--level=3") and parses any attached value as a
+// signed 16 bit integer. The flag is removed from the argument list. A
+// following argument is never absorbed as the value.
+//
+// If the flag appears more than once, or if the attached value has invalid
+// syntax or is out of range for an int16, an error is registered.
+//
+// Returns the parsed value (or implied if no value was attached) and a boolean
+// indicating whether the flag was found.
+func (args *Args) ValueOptionalInt16(
+	flag string, implied int16, desc string,
+) (int16, bool) {
+	return ValueOptional(args, ParseInt16, flag, implied, desc)
+}
+
+// ValueOptionalInt8 scans for a specific flagged argument whose value is
+// optional (e.g., "--level" or "--level=3") and parses any attached value as a
+// signed 8 bit integer. The flag is removed from the argument list. A
+// following argument is never absorbed as the value.
+//
+// If the flag appears more than once, or if the attached value has invalid
+// syntax or is out of range for an int8, an error is registered.
+//
+// Returns the parsed value (or implied if no value was attached) and a boolean
+// indicating whether the flag was found.
+func (args *Args) ValueOptionalInt8(
+	flag string, implied int8, desc string,
+) (int8, bool) {
+	return ValueOptional(args, ParseInt8, flag, implied, desc)
+}
+
+// ValueOptionalInt scans for a specific flagged argument whose value is
+// optional (e.g., "--level" or "--level=3") and parses any attached value as a
+// signed integer. The flag is removed from the argument list. A following
+// argument is never absorbed as the value.
+//
+// If the flag appears more than once, or if the attached value has invalid
+// syntax or is out of range for an int, an error is registered.
+//
+// Returns the parsed value (or implied if no value was attached) and a boolean
+// indicating whether the flag was found.
+func (args *Args) ValueOptionalInt(
+	flag string, implied int, desc string,
+) (int, bool) {
+	return ValueOptional(args, ParseInt, flag, implied, desc)
+}
+
+// ValueOptionalUint64 scans for a specific flagged argument whose value is
+// optional (e.g., "--level" or "--level=3") and parses any attached value as
+// an unsigned 64 bit integer. The flag is removed from the argument list. A
+// following argument is never absorbed as the value.
+//
+// If the flag appears more than once, or if the attached value has invalid
+// syntax or is out of range for a uint64, an error is registered.
+//
+// Returns the parsed value (or implied if no value was attached) and a boolean
+// indicating whether the flag was found.
+func (args *Args) ValueOptionalUint64(
+	flag string, implied uint64, desc string,
+) (uint64, bool) {
+	return ValueOptional(args, ParseUint64, flag, implied, desc)
+}
+
+// ValueOptionalUint32 scans for a specific flagged argument whose value is
+// optional (e.g., "--level" or "--level=3") and parses any attached value as
+// an unsigned 32 bit integer. The flag is removed from the argument list. A
+// following argument is never absorbed as the value.
+//
+// If the flag appears more than once, or if the attached value has invalid
+// syntax or is out of range for a uint32, an error is registered.
+//
+// Returns the parsed value (or implied if no value was attached) and a boolean
+// indicating whether the flag was found.
+func (args *Args) ValueOptionalUint32(
+	flag string, implied uint32, desc string,
+) (uint32, bool) {
+	return ValueOptional(args, ParseUint32, flag, implied, desc)
+}
+
+// ValueOptionalUint16 scans for a specific flagged argument whose value is
+// optional (e.g., "--level" or "--level=3") and parses any attached value as
+// an unsigned 16 bit integer. The flag is removed from the argument list. A
+// following argument is never absorbed as the value.
+//
+// If the flag appears more than once, or if the attached value has invalid
+// syntax or is out of range for a uint16, an error is registered.
+//
+// Returns the parsed value (or implied if no value was attached) and a boolean
+// indicating whether the flag was found.
+func (args *Args) ValueOptionalUint16(
+	flag string, implied uint16, desc string,
+) (uint16, bool) {
+	return ValueOptional(args, ParseUint16, flag, implied, desc)
+}
+
+// ValueOptionalUint8 scans for a specific flagged argument whose value is
+// optional (e.g., "--level" or "--level=3") and parses any attached value as
+// an unsigned 8 bit integer. The flag is removed from the argument list. A
+// following argument is never absorbed as the value.
+//
+// If the flag appears more than once, or if the attached value has invalid
+// syntax or is out of range for a uint8, an error is registered.
+//
+// Returns the parsed value (or implied if no value was attached) and a boolean
+// indicating whether the flag was found.
+func (args *Args) ValueOptionalUint8(
+	flag string, implied uint8, desc string,
+) (uint8, bool) {
+	return ValueOptional(args, ParseUint8, flag, implied, desc)
+}
+
+// ValueOptionalUint scans for a specific flagged argument whose value is
+// optional (e.g., "--level" or "--level=3") and parses any attached value as
+// an unsigned integer. The flag is removed from the argument list. A following
+// argument is never absorbed as the value.
+//
+// If the flag appears more than once, or if the attached value has invalid
+// syntax or is out of range for a uint, an error is registered.
+//
+// Returns the parsed value (or implied if no value was attached) and a boolean
+// indicating whether the flag was found.
+func (args *Args) ValueOptionalUint(
+	flag string, implied uint, desc string,
+) (uint, bool) {
+	return ValueOptional(args, ParseUint, flag, implied, desc)
+}
+
+// ValueOptionalOption scans for a specific flagged argument whose value is
+// optional (e.g., "--color" or "--color=always") and captures any attached
+// value. The flag is removed from the argument list. A following argument is
+// never absorbed as the value.
+//
+// If the flag appears more than once, or if the attached value is not found in
+// the provided list of validOptions, an error is registered.
+//
+// Returns the attached value (or implied if no value was attached) and a
+// boolean indicating whether the flag was found.
+func (args *Args) ValueOptionalOption(
+	flag, implied string, validOptions []string, desc string,
+) (string, bool) {
+	return args.ValueOptionalOptionMatch(
+		flag, implied, validOptions, OptionExact, desc,
+	)
+}
+
+// ValueOptionalOptionMatch is ValueOptionalOption matching the validOptions as
+// selected by the match modes (IE: OptionIgnoreCase | OptionPrefix).  The
+// canonical option matched is returned.
+func (args *Args) ValueOptionalOptionMatch(
+	flag, implied string, validOptions []string,
+	match OptionMatch, desc string,
+) (string, bool) {
+	return ValueOptional(
+		args, OptionParser(validOptions, match), flag, implied, desc,
+	)
+}
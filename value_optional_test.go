@@ -0,0 +1,718 @@
+/*
+   Szerszam argument library: szargs.
+   Copyright (C) 2024-2025  Leslie Dancsecs
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU General Public License for more details.
+
+   You should have received a copy of the GNU General Public License
+   along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package szargs_test
+
+import (
+	"testing"
+
+	"github.com/dancsecs/szargs"
+	"github.com/dancsecs/sztestlog"
+)
+
+const tstOptionalFlag = "[-c | --color[=when]]"
+
+func TestSzargs_ValueOptionalString_Missing(t *testing.T) {
+	chk := sztestlog.CaptureNothing(t)
+	defer chk.Release()
+
+	args := szargs.New("program description", []string{
+		"programName",
+		"file",
+	})
+
+	result, found := args.ValueOptionalString(
+		tstOptionalFlag, "auto", "colorize output",
+	)
+
+	chk.NoErr(args.Err())
+	chk.False(found)
+	chk.Str(result, "")
+	chk.StrSlice(args.Args(), []string{"file"})
+}
+
+func TestSzargs_ValueOptionalString_Implied(t *testing.T) {
+	chk := sztestlog.CaptureNothing(t)
+	defer chk.Release()
+
+	args := szargs.New("program description", []string{
+		"programName",
+		"--color",
+		"file",
+	})
+
+	result, found := args.ValueOptionalString(
+		tstOptionalFlag, "auto", "colorize output",
+	)
+
+	chk.NoErr(args.Err())
+	chk.True(found)
+	chk.Str(result, "auto")
+	chk.StrSlice(args.Args(), []string{"file"})
+}
+
+func TestSzargs_ValueOptionalString_Attached(t *testing.T) {
+	chk := sztestlog.CaptureNothing(t)
+	defer chk.Release()
+
+	args := szargs.New("program description", []string{
+		"programName",
+		"--color=always",
+		"file",
+	})
+
+	result, found := args.ValueOptionalString(
+		tstOptionalFlag, "auto", "colorize output",
+	)
+
+	chk.NoErr(args.Err())
+	chk.True(found)
+	chk.Str(result, "always")
+	chk.StrSlice(args.Args(), []string{"file"})
+
+	args = szargs.New("program description", []string{
+		"programName",
+		"-vcnever",
+		"file",
+	})
+
+	chk.True(args.Is("-v", "verbose"))
+
+	result, found = args.ValueOptionalString(
+		tstOptionalFlag, "auto", "colorize output",
+	)
+
+	chk.NoErr(args.Err())
+	chk.True(found)
+	chk.Str(result, "never")
+	chk.StrSlice(args.Args(), []string{"file"})
+}
+
+func TestSzargs_ValueOptionalString_Ambiguous(t *testing.T) {
+	chk := sztestlog.CaptureNothing(t)
+	defer chk.Release()
+
+	args := szargs.New("program description", []string{
+		"programName",
+		"--color",
+		"-c",
+	})
+
+	result, found := args.ValueOptionalString(
+		tstOptionalFlag, "auto", "colorize output",
+	)
+
+	chk.Err(
+		args.Err(),
+		chk.ErrChain(
+			szargs.ErrAmbiguous,
+			"'"+tstOptionalFlag+"' found 2 times",
+		),
+	)
+	chk.False(found)
+	chk.Str(result, "")
+	chk.StrSlice(args.Args(), nil)
+}
+
+func TestSzargs_ValueOptionalString_Registered(t *testing.T) {
+	chk := sztestlog.CaptureNothing(t)
+	defer chk.Release()
+
+	args := szargs.New("program description", []string{
+		"programName",
+		"-c",
+		"-v",
+	})
+
+	// Registered up front so -c is known not to absorb the following -v.
+	args.RegisterUsage(tstOptionalFlag, "colorize output")
+
+	chk.True(args.Is("-v", "verbose"))
+
+	result, found := args.ValueOptionalString(
+		tstOptionalFlag, "auto", "colorize output",
+	)
+
+	chk.NoErr(args.Err())
+	chk.True(found)
+	chk.Str(result, "auto")
+	chk.StrSlice(args.Args(), nil)
+}
+
+func TestSzargs_ValueOptionalInt(t *testing.T) {
+	chk := sztestlog.CaptureNothing(t)
+	defer chk.Release()
+
+	args := szargs.New("program description", []string{
+		"programName",
+		"--level",
+		"7",
+	})
+
+	result, found := args.ValueOptionalInt(
+		"[--level[=n]]", 3, "the level",
+	)
+
+	chk.NoErr(args.Err())
+	chk.True(found)
+	chk.Int(result, 3)
+	chk.StrSlice(args.Args(), []string{"7"})
+
+	args = szargs.New("program description", []string{
+		"programName",
+		"--level=5",
+	})
+
+	result, found = args.ValueOptionalInt(
+		"[--level[=n]]", 3, "the level",
+	)
+
+	chk.NoErr(args.Err())
+	chk.True(found)
+	chk.Int(result, 5)
+	chk.StrSlice(args.Args(), nil)
+}
+
+func TestSzargs_ValueOptionalInt_Invalid(t *testing.T) {
+	chk := sztestlog.CaptureNothing(t)
+	defer chk.Release()
+
+	args := szargs.New("program description", []string{
+		"programName",
+		"--level=high",
+	})
+
+	result, found := args.ValueOptionalInt(
+		"[--level[=n]]", 3, "the level",
+	)
+
+	chk.Err(
+		args.Err(),
+		chk.ErrChain(
+			szargs.ErrInvalidInt,
+			szargs.ErrSyntax,
+			"[--level[=n]]",
+			"'high'",
+		),
+	)
+	chk.False(found)
+	chk.Int(result, 0)
+	chk.StrSlice(args.Args(), nil)
+}
+
+func TestSzargs_ValueOptionalOption(t *testing.T) {
+	chk := sztestlog.CaptureNothing(t)
+	defer chk.Release()
+
+	validOptions := []string{"always", "auto", "never"}
+
+	args := szargs.New("program description", []string{
+		"programName",
+		"--color",
+	})
+
+	result, found := args.ValueOptionalOption(
+		tstOptionalFlag, "auto", validOptions, "colorize output",
+	)
+
+	chk.NoErr(args.Err())
+	chk.True(found)
+	chk.Str(result, "auto")
+
+	args = szargs.New("program description", []string{
+		"programName",
+		"--color=sometimes",
+	})
+
+	result, found = args.ValueOptionalOption(
+		tstOptionalFlag, "auto", validOptions, "colorize output",
+	)
+
+	chk.Err(
+		args.Err(),
+		chk.ErrChain(
+			szargs.ErrInvalidOption,
+			"'sometimes' ("+tstOptionalFlag+
+				" must be one of [always auto never])",
+		),
+	)
+	chk.False(found)
+	chk.Str(result, "")
+}
+
+func TestSzargs_ValueOptionalFloat64(t *testing.T) {
+	chk := sztestlog.CaptureNothing(t)
+	defer chk.Release()
+
+	args := szargs.New("program description", []string{
+		"programName",
+		"--level",
+		"7",
+	})
+
+	result, found := args.ValueOptionalFloat64(
+		"[--level[=n]]", 3, "the level",
+	)
+
+	chk.NoErr(args.Err())
+	chk.True(found)
+	chk.Float64(result, 3, 0)
+	chk.StrSlice(args.Args(), []string{"7"})
+
+	args = szargs.New("program description", []string{
+		"programName",
+		"--level=high",
+	})
+
+	result, found = args.ValueOptionalFloat64(
+		"[--level[=n]]", 3, "the level",
+	)
+
+	chk.Err(
+		args.Err(),
+		chk.ErrChain(
+			szargs.ErrInvalidFloat64,
+			szargs.ErrSyntax,
+			"[--level[=n]]",
+			"'high'",
+		),
+	)
+	chk.False(found)
+	chk.Float64(result, 0, 0)
+	chk.StrSlice(args.Args(), nil)
+}
+
+func TestSzargs_ValueOptionalFloat32(t *testing.T) {
+	chk := sztestlog.CaptureNothing(t)
+	defer chk.Release()
+
+	args := szargs.New("program description", []string{
+		"programName",
+		"--level",
+		"7",
+	})
+
+	result, found := args.ValueOptionalFloat32(
+		"[--level[=n]]", 3, "the level",
+	)
+
+	chk.NoErr(args.Err())
+	chk.True(found)
+	chk.Float32(result, 3, 0)
+	chk.StrSlice(args.Args(), []string{"7"})
+
+	args = szargs.New("program description", []string{
+		"programName",
+		"--level=high",
+	})
+
+	result, found = args.ValueOptionalFloat32(
+		"[--level[=n]]", 3, "the level",
+	)
+
+	chk.Err(
+		args.Err(),
+		chk.ErrChain(
+			szargs.ErrInvalidFloat32,
+			szargs.ErrSyntax,
+			"[--level[=n]]",
+			"'high'",
+		),
+	)
+	chk.False(found)
+	chk.Float32(result, 0, 0)
+	chk.StrSlice(args.Args(), nil)
+}
+
+func TestSzargs_ValueOptionalInt64(t *testing.T) {
+	chk := sztestlog.CaptureNothing(t)
+	defer chk.Release()
+
+	args := szargs.New("program description", []string{
+		"programName",
+		"--level",
+		"7",
+	})
+
+	result, found := args.ValueOptionalInt64(
+		"[--level[=n]]", 3, "the level",
+	)
+
+	chk.NoErr(args.Err())
+	chk.True(found)
+	chk.Int64(result, 3)
+	chk.StrSlice(args.Args(), []string{"7"})
+
+	args = szargs.New("program description", []string{
+		"programName",
+		"--level=high",
+	})
+
+	result, found = args.ValueOptionalInt64(
+		"[--level[=n]]", 3, "the level",
+	)
+
+	chk.Err(
+		args.Err(),
+		chk.ErrChain(
+			szargs.ErrInvalidInt64,
+			szargs.ErrSyntax,
+			"[--level[=n]]",
+			"'high'",
+		),
+	)
+	chk.False(found)
+	chk.Int64(result, 0)
+	chk.StrSlice(args.Args(), nil)
+}
+
+func TestSzargs_ValueOptionalInt32(t *testing.T) {
+	chk := sztestlog.CaptureNothing(t)
+	defer chk.Release()
+
+	args := szargs.New("program description", []string{
+		"programName",
+		"--level",
+		"7",
+	})
+
+	result, found := args.ValueOptionalInt32(
+		"[--level[=n]]", 3, "the level",
+	)
+
+	chk.NoErr(args.Err())
+	chk.True(found)
+	chk.Int32(result, 3)
+	chk.StrSlice(args.Args(), []string{"7"})
+
+	args = szargs.New("program description", []string{
+		"programName",
+		"--level=high",
+	})
+
+	result, found = args.ValueOptionalInt32(
+		"[--level[=n]]", 3, "the level",
+	)
+
+	chk.Err(
+		args.Err(),
+		chk.ErrChain(
+			szargs.ErrInvalidInt32,
+			szargs.ErrSyntax,
+			"[--level[=n]]",
+			"'high'",
+		),
+	)
+	chk.False(found)
+	chk.Int32(result, 0)
+	chk.StrSlice(args.Args(), nil)
+}
+
+func TestSzargs_ValueOptionalInt16(t *testing.T) {
+	chk := sztestlog.CaptureNothing(t)
+	defer chk.Release()
+
+	args := szargs.New("program description", []string{
+		"programName",
+		"--level",
+		"7",
+	})
+
+	result, found := args.ValueOptionalInt16(
+		"[--level[=n]]", 3, "the level",
+	)
+
+	chk.NoErr(args.Err())
+	chk.True(found)
+	chk.Int16(result, 3)
+	chk.StrSlice(args.Args(), []string{"7"})
+
+	args = szargs.New("program description", []string{
+		"programName",
+		"--level=high",
+	})
+
+	result, found = args.ValueOptionalInt16(
+		"[--level[=n]]", 3, "the level",
+	)
+
+	chk.Err(
+		args.Err(),
+		chk.ErrChain(
+			szargs.ErrInvalidInt16,
+			szargs.ErrSyntax,
+			"[--level[=n]]",
+			"'high'",
+		),
+	)
+	chk.False(found)
+	chk.Int16(result, 0)
+	chk.StrSlice(args.Args(), nil)
+}
+
+func TestSzargs_ValueOptionalInt8(t *testing.T) {
+	chk := sztestlog.CaptureNothing(t)
+	defer chk.Release()
+
+	args := szargs.New("program description", []string{
+		"programName",
+		"--level",
+		"7",
+	})
+
+	result, found := args.ValueOptionalInt8(
+		"[--level[=n]]", 3, "the level",
+	)
+
+	chk.NoErr(args.Err())
+	chk.True(found)
+	chk.Int8(result, 3)
+	chk.StrSlice(args.Args(), []string{"7"})
+
+	args = szargs.New("program description", []string{
+		"programName",
+		"--level=high",
+	})
+
+	result, found = args.ValueOptionalInt8(
+		"[--level[=n]]", 3, "the level",
+	)
+
+	chk.Err(
+		args.Err(),
+		chk.ErrChain(
+			szargs.ErrInvalidInt8,
+			szargs.ErrSyntax,
+			"[--level[=n]]",
+			"'high'",
+		),
+	)
+	chk.False(found)
+	chk.Int8(result, 0)
+	chk.StrSlice(args.Args(), nil)
+}
+
+func TestSzargs_ValueOptionalUint64(t *testing.T) {
+	chk := sztestlog.CaptureNothing(t)
+	defer chk.Release()
+
+	args := szargs.New("program description", []string{
+		"programName",
+		"--level",
+		"7",
+	})
+
+	result, found := args.ValueOptionalUint64(
+		"[--level[=n]]", 3, "the level",
+	)
+
+	chk.NoErr(args.Err())
+	chk.True(found)
+	chk.Uint64(result, 3)
+	chk.StrSlice(args.Args(), []string{"7"})
+
+	args = szargs.New("program description", []string{
+		"programName",
+		"--level=high",
+	})
+
+	result, found = args.ValueOptionalUint64(
+		"[--level[=n]]", 3, "the level",
+	)
+
+	chk.Err(
+		args.Err(),
+		chk.ErrChain(
+			szargs.ErrInvalidUint64,
+			szargs.ErrSyntax,
+			"[--level[=n]]",
+			"'high'",
+		),
+	)
+	chk.False(found)
+	chk.Uint64(result, 0)
+	chk.StrSlice(args.Args(), nil)
+}
+
+func TestSzargs_ValueOptionalUint32(t *testing.T) {
+	chk := sztestlog.CaptureNothing(t)
+	defer chk.Release()
+
+	args := szargs.New("program description", []string{
+		"programName",
+		"--level",
+		"7",
+	})
+
+	result, found := args.ValueOptionalUint32(
+		"[--level[=n]]", 3, "the level",
+	)
+
+	chk.NoErr(args.Err())
+	chk.True(found)
+	chk.Uint32(result, 3)
+	chk.StrSlice(args.Args(), []string{"7"})
+
+	args = szargs.New("program description", []string{
+		"programName",
+		"--level=high",
+	})
+
+	result, found = args.ValueOptionalUint32(
+		"[--level[=n]]", 3, "the level",
+	)
+
+	chk.Err(
+		args.Err(),
+		chk.ErrChain(
+			szargs.ErrInvalidUint32,
+			szargs.ErrSyntax,
+			"[--level[=n]]",
+			"'high'",
+		),
+	)
+	chk.False(found)
+	chk.Uint32(result, 0)
+	chk.StrSlice(args.Args(), nil)
+}
+
+func TestSzargs_ValueOptionalUint16(t *testing.T) {
+	chk := sztestlog.CaptureNothing(t)
+	defer chk.Release()
+
+	args := szargs.New("program description", []string{
+		"programName",
+		"--level",
+		"7",
+	})
+
+	result, found := args.ValueOptionalUint16(
+		"[--level[=n]]", 3, "the level",
+	)
+
+	chk.NoErr(args.Err())
+	chk.True(found)
+	chk.Uint16(result, 3)
+	chk.StrSlice(args.Args(), []string{"7"})
+
+	args = szargs.New("program description", []string{
+		"programName",
+		"--level=high",
+	})
+
+	result, found = args.ValueOptionalUint16(
+		"[--level[=n]]", 3, "the level",
+	)
+
+	chk.Err(
+		args.Err(),
+		chk.ErrChain(
+			szargs.ErrInvalidUint16,
+			szargs.ErrSyntax,
+			"[--level[=n]]",
+			"'high'",
+		),
+	)
+	chk.False(found)
+	chk.Uint16(result, 0)
+	chk.StrSlice(args.Args(), nil)
+}
+
+func TestSzargs_ValueOptionalUint8(t *testing.T) {
+	chk := sztestlog.CaptureNothing(t)
+	defer chk.Release()
+
+	args := szargs.New("program description", []string{
+		"programName",
+		"--level",
+		"7",
+	})
+
+	result, found := args.ValueOptionalUint8(
+		"[--level[=n]]", 3, "the level",
+	)
+
+	chk.NoErr(args.Err())
+	chk.True(found)
+	chk.Uint8(result, 3)
+	chk.StrSlice(args.Args(), []string{"7"})
+
+	args = szargs.New("program description", []string{
+		"programName",
+		"--level=high",
+	})
+
+	result, found = args.ValueOptionalUint8(
+		"[--level[=n]]", 3, "the level",
+	)
+
+	chk.Err(
+		args.Err(),
+		chk.ErrChain(
+			szargs.ErrInvalidUint8,
+			szargs.ErrSyntax,
+			"[--level[=n]]",
+			"'high'",
+		),
+	)
+	chk.False(found)
+	chk.Uint8(result, 0)
+	chk.StrSlice(args.Args(), nil)
+}
+
+func TestSzargs_ValueOptionalUint(t *testing.T) {
+	chk := sztestlog.CaptureNothing(t)
+	defer chk.Release()
+
+	args := szargs.New("program description", []string{
+		"programName",
+		"--level",
+		"7",
+	})
+
+	result, found := args.ValueOptionalUint(
+		"[--level[=n]]", 3, "the level",
+	)
+
+	chk.NoErr(args.Err())
+	chk.True(found)
+	chk.Uint(result, 3)
+	chk.StrSlice(args.Args(), []string{"7"})
+
+	args = szargs.New("program description", []string{
+		"programName",
+		"--level=high",
+	})
+
+	result, found = args.ValueOptionalUint(
+		"[--level[=n]]", 3, "the level",
+	)
+
+	chk.Err(
+		args.Err(),
+		chk.ErrChain(
+			szargs.ErrInvalidUint,
+			szargs.ErrSyntax,
+			"[--level[=n]]",
+			"'high'",
+		),
+	)
+	chk.False(found)
+	chk.Uint(result, 0)
+	chk.StrSlice(args.Args(), nil)
+}
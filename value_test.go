@@ -76,6 +76,73 @@ func TestSzargs_ValueString_Success(t *testing.T) {
 	)
 }
 
+func TestSzargs_ValueString_Attached(t *testing.T) {
+	chk := sztestlog.CaptureNothing(t)
+	defer chk.Release()
+
+	args := szargs.New("program description", []string{
+		"programName",
+		"309",
+		"--test=testValue",
+		"anotherArg",
+	})
+
+	result, found := args.ValueString("[-t | --test value]", "the test flag")
+
+	chk.NoErr(args.Err())
+	chk.True(found)
+	chk.Str(result, "testValue")
+	chk.StrSlice(
+		args.Args(),
+		[]string{
+			"309",
+			"anotherArg",
+		},
+	)
+}
+
+func TestSzargs_ValueInt_AttachedShort(t *testing.T) {
+	chk := sztestlog.CaptureNothing(t)
+	defer chk.Release()
+
+	args := szargs.New("program description", []string{
+		"programName",
+		"-n5",
+		"anotherArg",
+	})
+
+	result, found := args.ValueInt("[-n | --number num]", "the test flag")
+
+	chk.NoErr(args.Err())
+	chk.True(found)
+	chk.Int(result, 5)
+	chk.StrSlice(args.Args(), []string{"anotherArg"})
+}
+
+func TestSzargs_ValueInt_AttachedAmbiguous(t *testing.T) {
+	chk := sztestlog.CaptureNothing(t)
+	defer chk.Release()
+
+	args := szargs.New("program description", []string{
+		"programName",
+		"-n5",
+		"--number=6",
+	})
+
+	result, found := args.ValueInt("[-n | --number num]", "the test flag")
+
+	chk.Err(
+		args.Err(),
+		chk.ErrChain(
+			szargs.ErrAmbiguous,
+			"'[-n | --number num]' for '6' already set to: '5'",
+		),
+	)
+	chk.False(found)
+	chk.Int(result, 0)
+	chk.StrSlice(args.Args(), nil)
+}
+
 /*
  ***************************************************************************
  *
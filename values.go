@@ -18,8 +18,6 @@
 
 package szargs
 
-import "fmt"
-
 // ValuesString scans for repeated instances of the specified flag and
 // captures the following values as a slice of strings. The flags and values
 // are removed from the argument list.
@@ -30,8 +28,7 @@ import "fmt"
 // Returns a slice of the captured string values.
 func (args *Args) ValuesString(flag, desc string) []string {
 	args.RegisterUsage(flag, desc)
-	result, newArgs, err := argFlag(flag).values(args.args)
-	args.args = newArgs
+	result, _, err := args.scanValues(flag)
 	args.PushErr(err)
 
 	return result
@@ -46,47 +43,7 @@ func (args *Args) ValuesString(flag, desc string) []string {
 //
 // Returns a slice of the parsed float64 values.
 func (args *Args) ValuesFloat64(flag, desc string) []float64 {
-	var (
-		matches     []string
-		cleanedArgs []string
-		result      []float64
-		err         error
-	)
-
-	args.RegisterUsage(flag, desc)
-
-	matches, cleanedArgs, err = argFlag(flag).values(args.Args())
-
-	if err == nil { //nolint:nestif // Ok.
-		var (
-			argItem float64
-			argErr  error
-		)
-
-		result = make([]float64, len(matches))
-
-		for i, arg := range matches {
-			argItem, argErr = parseFloat64(flag, arg)
-			if argErr != nil {
-				if err == nil {
-					err = argErr
-				} else {
-					err = fmt.Errorf("%w: %w", err, argErr)
-				}
-			} else {
-				result[i] = argItem
-			}
-		}
-	}
-
-	args.args = cleanedArgs
-	args.PushErr(err)
-
-	if err == nil {
-		return result
-	}
-
-	return nil
+	return Values(args, ParseFloat64, flag, desc)
 }
 
 // ValuesFloat32 scans for repeated instances of the specified flag and parses
@@ -98,47 +55,7 @@ func (args *Args) ValuesFloat64(flag, desc string) []float64 {
 //
 // Returns a slice of the parsed float32 values.
 func (args *Args) ValuesFloat32(flag, desc string) []float32 {
-	var (
-		matches     []string
-		cleanedArgs []string
-		result      []float32
-		err         error
-	)
-
-	args.RegisterUsage(flag, desc)
-
-	matches, cleanedArgs, err = argFlag(flag).values(args.Args())
-
-	if err == nil { //nolint:nestif // Ok.
-		var (
-			argItem float32
-			argErr  error
-		)
-
-		result = make([]float32, len(matches))
-
-		for i, arg := range matches {
-			argItem, argErr = parseFloat32(flag, arg)
-			if argErr != nil {
-				if err == nil {
-					err = argErr
-				} else {
-					err = fmt.Errorf("%w: %w", err, argErr)
-				}
-			} else {
-				result[i] = argItem
-			}
-		}
-	}
-
-	args.args = cleanedArgs
-	args.PushErr(err)
-
-	if err == nil {
-		return result
-	}
-
-	return nil
+	return Values(args, ParseFloat32, flag, desc)
 }
 
 // ValuesInt64 scans for repeated instances of the specified flag and parses
@@ -150,47 +67,7 @@ func (args *Args) ValuesFloat32(flag, desc string) []float32 {
 //
 // Returns a slice of the parsed int64 values.
 func (args *Args) ValuesInt64(flag, desc string) []int64 {
-	var (
-		matches     []string
-		cleanedArgs []string
-		result      []int64
-		err         error
-	)
-
-	args.RegisterUsage(flag, desc)
-
-	matches, cleanedArgs, err = argFlag(flag).values(args.Args())
-
-	if err == nil { //nolint:nestif // Ok.
-		var (
-			argItem int64
-			argErr  error
-		)
-
-		result = make([]int64, len(matches))
-
-		for i, arg := range matches {
-			argItem, argErr = parseInt64(flag, arg)
-			if argErr != nil {
-				if err == nil {
-					err = argErr
-				} else {
-					err = fmt.Errorf("%w: %w", err, argErr)
-				}
-			} else {
-				result[i] = argItem
-			}
-		}
-	}
-
-	args.args = cleanedArgs
-	args.PushErr(err)
-
-	if err == nil {
-		return result
-	}
-
-	return nil
+	return Values(args, ParseInt64, flag, desc)
 }
 
 // ValuesInt32 scans for repeated instances of the specified flag and parses
@@ -202,47 +79,7 @@ func (args *Args) ValuesInt64(flag, desc string) []int64 {
 //
 // Returns a slice of the parsed int32 values.
 func (args *Args) ValuesInt32(flag, desc string) []int32 {
-	var (
-		matches     []string
-		cleanedArgs []string
-		result      []int32
-		err         error
-	)
-
-	args.RegisterUsage(flag, desc)
-
-	matches, cleanedArgs, err = argFlag(flag).values(args.Args())
-
-	if err == nil { //nolint:nestif // Ok.
-		var (
-			argItem int32
-			argErr  error
-		)
-
-		result = make([]int32, len(matches))
-
-		for i, arg := range matches {
-			argItem, argErr = parseInt32(flag, arg)
-			if argErr != nil {
-				if err == nil {
-					err = argErr
-				} else {
-					err = fmt.Errorf("%w: %w", err, argErr)
-				}
-			} else {
-				result[i] = argItem
-			}
-		}
-	}
-
-	args.args = cleanedArgs
-	args.PushErr(err)
-
-	if err == nil {
-		return result
-	}
-
-	return nil
+	return Values(args, ParseInt32, flag, desc)
 }
 
 // ValuesInt16 scans for repeated instances of the specified flag and parses
@@ -254,47 +91,7 @@ func (args *Args) ValuesInt32(flag, desc string) []int32 {
 //
 // Returns a slice of the parsed int16 values.
 func (args *Args) ValuesInt16(flag, desc string) []int16 {
-	var (
-		matches     []string
-		cleanedArgs []string
-		result      []int16
-		err         error
-	)
-
-	args.RegisterUsage(flag, desc)
-
-	matches, cleanedArgs, err = argFlag(flag).values(args.Args())
-
-	if err == nil { //nolint:nestif // Ok.
-		var (
-			argItem int16
-			argErr  error
-		)
-
-		result = make([]int16, len(matches))
-
-		for i, arg := range matches {
-			argItem, argErr = parseInt16(flag, arg)
-			if argErr != nil {
-				if err == nil {
-					err = argErr
-				} else {
-					err = fmt.Errorf("%w: %w", err, argErr)
-				}
-			} else {
-				result[i] = argItem
-			}
-		}
-	}
-
-	args.args = cleanedArgs
-	args.PushErr(err)
-
-	if err == nil {
-		return result
-	}
-
-	return nil
+	return Values(args, ParseInt16, flag, desc)
 }
 
 // ValuesInt8 scans for repeated instances of the specified flag and parses
@@ -306,47 +103,7 @@ func (args *Args) ValuesInt16(flag, desc string) []int16 {
 //
 // Returns a slice of the parsed int8 values.
 func (args *Args) ValuesInt8(flag, desc string) []int8 {
-	var (
-		matches     []string
-		cleanedArgs []string
-		result      []int8
-		err         error
-	)
-
-	args.RegisterUsage(flag, desc)
-
-	matches, cleanedArgs, err = argFlag(flag).values(args.Args())
-
-	if err == nil { //nolint:nestif // Ok.
-		var (
-			argItem int8
-			argErr  error
-		)
-
-		result = make([]int8, len(matches))
-
-		for i, arg := range matches {
-			argItem, argErr = parseInt8(flag, arg)
-			if argErr != nil {
-				if err == nil {
-					err = argErr
-				} else {
-					err = fmt.Errorf("%w: %w", err, argErr)
-				}
-			} else {
-				result[i] = argItem
-			}
-		}
-	}
-
-	args.args = cleanedArgs
-	args.PushErr(err)
-
-	if err == nil {
-		return result
-	}
-
-	return nil
+	return Values(args, ParseInt8, flag, desc)
 }
 
 // ValuesInt scans for repeated instances of the specified flag and parses
@@ -358,47 +115,7 @@ func (args *Args) ValuesInt8(flag, desc string) []int8 {
 //
 // Returns a slice of the parsed int values.
 func (args *Args) ValuesInt(flag, desc string) []int {
-	var (
-		matches     []string
-		cleanedArgs []string
-		result      []int
-		err         error
-	)
-
-	args.RegisterUsage(flag, desc)
-
-	matches, cleanedArgs, err = argFlag(flag).values(args.Args())
-
-	if err == nil { //nolint:nestif // Ok.
-		var (
-			argItem int
-			argErr  error
-		)
-
-		result = make([]int, len(matches))
-
-		for i, arg := range matches {
-			argItem, argErr = parseInt(flag, arg)
-			if argErr != nil {
-				if err == nil {
-					err = argErr
-				} else {
-					err = fmt.Errorf("%w: %w", err, argErr)
-				}
-			} else {
-				result[i] = argItem
-			}
-		}
-	}
-
-	args.args = cleanedArgs
-	args.PushErr(err)
-
-	if err == nil {
-		return result
-	}
-
-	return nil
+	return Values(args, ParseInt, flag, desc)
 }
 
 // ValuesUint64 scans for repeated instances of the specified flag and parses
@@ -410,47 +127,7 @@ func (args *Args) ValuesInt(flag, desc string) []int {
 //
 // Returns a slice of the parsed uint64 values.
 func (args *Args) ValuesUint64(flag, desc string) []uint64 {
-	var (
-		matches     []string
-		cleanedArgs []string
-		result      []uint64
-		err         error
-	)
-
-	args.RegisterUsage(flag, desc)
-
-	matches, cleanedArgs, err = argFlag(flag).values(args.Args())
-
-	if err == nil { //nolint:nestif // Ok.
-		var (
-			argItem uint64
-			argErr  error
-		)
-
-		result = make([]uint64, len(matches))
-
-		for i, arg := range matches {
-			argItem, argErr = parseUint64(flag, arg)
-			if argErr != nil {
-				if err == nil {
-					err = argErr
-				} else {
-					err = fmt.Errorf("%w: %w", err, argErr)
-				}
-			} else {
-				result[i] = argItem
-			}
-		}
-	}
-
-	args.args = cleanedArgs
-	args.PushErr(err)
-
-	if err == nil {
-		return result
-	}
-
-	return nil
+	return Values(args, ParseUint64, flag, desc)
 }
 
 // ValuesUint32 scans for repeated instances of the specified flag and parses
@@ -462,47 +139,7 @@ func (args *Args) ValuesUint64(flag, desc string) []uint64 {
 //
 // Returns a slice of the parsed uint32 values.
 func (args *Args) ValuesUint32(flag, desc string) []uint32 {
-	var (
-		matches     []string
-		cleanedArgs []string
-		result      []uint32
-		err         error
-	)
-
-	args.RegisterUsage(flag, desc)
-
-	matches, cleanedArgs, err = argFlag(flag).values(args.Args())
-
-	if err == nil { //nolint:nestif // Ok.
-		var (
-			argItem uint32
-			argErr  error
-		)
-
-		result = make([]uint32, len(matches))
-
-		for i, arg := range matches {
-			argItem, argErr = parseUint32(flag, arg)
-			if argErr != nil {
-				if err == nil {
-					err = argErr
-				} else {
-					err = fmt.Errorf("%w: %w", err, argErr)
-				}
-			} else {
-				result[i] = argItem
-			}
-		}
-	}
-
-	args.args = cleanedArgs
-	args.PushErr(err)
-
-	if err == nil {
-		return result
-	}
-
-	return nil
+	return Values(args, ParseUint32, flag, desc)
 }
 
 // ValuesUint16 scans for repeated instances of the specified flag and parses
@@ -514,47 +151,7 @@ func (args *Args) ValuesUint32(flag, desc string) []uint32 {
 //
 // Returns a slice of the parsed uint16 values.
 func (args *Args) ValuesUint16(flag, desc string) []uint16 {
-	var (
-		matches     []string
-		cleanedArgs []string
-		result      []uint16
-		err         error
-	)
-
-	args.RegisterUsage(flag, desc)
-
-	matches, cleanedArgs, err = argFlag(flag).values(args.Args())
-
-	if err == nil { //nolint:nestif // Ok.
-		var (
-			argItem uint16
-			argErr  error
-		)
-
-		result = make([]uint16, len(matches))
-
-		for i, arg := range matches {
-			argItem, argErr = parseUint16(flag, arg)
-			if argErr != nil {
-				if err == nil {
-					err = argErr
-				} else {
-					err = fmt.Errorf("%w: %w", err, argErr)
-				}
-			} else {
-				result[i] = argItem
-			}
-		}
-	}
-
-	args.args = cleanedArgs
-	args.PushErr(err)
-
-	if err == nil {
-		return result
-	}
-
-	return nil
+	return Values(args, ParseUint16, flag, desc)
 }
 
 // ValuesUint8 scans for repeated instances of the specified flag and parses
@@ -566,47 +163,7 @@ func (args *Args) ValuesUint16(flag, desc string) []uint16 {
 //
 // Returns a slice of the parsed uint8 values.
 func (args *Args) ValuesUint8(flag, desc string) []uint8 {
-	var (
-		matches     []string
-		cleanedArgs []string
-		result      []uint8
-		err         error
-	)
-
-	args.RegisterUsage(flag, desc)
-
-	matches, cleanedArgs, err = argFlag(flag).values(args.Args())
-
-	if err == nil { //nolint:nestif // Ok.
-		var (
-			argItem uint8
-			argErr  error
-		)
-
-		result = make([]uint8, len(matches))
-
-		for i, arg := range matches {
-			argItem, argErr = parseUint8(flag, arg)
-			if argErr != nil {
-				if err == nil {
-					err = argErr
-				} else {
-					err = fmt.Errorf("%w: %w", err, argErr)
-				}
-			} else {
-				result[i] = argItem
-			}
-		}
-	}
-
-	args.args = cleanedArgs
-	args.PushErr(err)
-
-	if err == nil {
-		return result
-	}
-
-	return nil
+	return Values(args, ParseUint8, flag, desc)
 }
 
 // ValuesUint scans for repeated instances of the specified flag and parses
@@ -618,47 +175,7 @@ func (args *Args) ValuesUint8(flag, desc string) []uint8 {
 //
 // Returns a slice of the parsed uint values.
 func (args *Args) ValuesUint(flag, desc string) []uint {
-	var (
-		matches     []string
-		cleanedArgs []string
-		result      []uint
-		err         error
-	)
-
-	args.RegisterUsage(flag, desc)
-
-	matches, cleanedArgs, err = argFlag(flag).values(args.Args())
-
-	if err == nil { //nolint:nestif // Ok.
-		var (
-			argItem uint
-			argErr  error
-		)
-
-		result = make([]uint, len(matches))
-
-		for i, arg := range matches {
-			argItem, argErr = parseUint(flag, arg)
-			if argErr != nil {
-				if err == nil {
-					err = argErr
-				} else {
-					err = fmt.Errorf("%w: %w", err, argErr)
-				}
-			} else {
-				result[i] = argItem
-			}
-		}
-	}
-
-	args.args = cleanedArgs
-	args.PushErr(err)
-
-	if err == nil {
-		return result
-	}
-
-	return nil
+	return Values(args, ParseUint, flag, desc)
 }
 
 // ValuesOption scans for repeated instances of the specified flag and
@@ -672,45 +189,14 @@ func (args *Args) ValuesUint(flag, desc string) []uint {
 func (args *Args) ValuesOption(
 	flag string, validOptions []string, desc string,
 ) []string {
-	var (
-		matches     []string
-		cleanedArgs []string
-		result      []string
-		err         error
-	)
-
-	args.RegisterUsage(flag, desc)
-
-	matches, cleanedArgs, err = argFlag(flag).values(args.Args())
-
-	if err == nil { //nolint:nestif // Ok.
-		var (
-			argItem string
-			argErr  error
-		)
-
-		result = make([]string, len(matches))
-
-		for i, arg := range matches {
-			argItem, argErr = parseOption(flag, arg, validOptions)
-			if argErr != nil {
-				if err == nil {
-					err = argErr
-				} else {
-					err = fmt.Errorf("%w: %w", err, argErr)
-				}
-			} else {
-				result[i] = argItem
-			}
-		}
-	}
-
-	args.args = cleanedArgs
-	args.PushErr(err)
-
-	if err == nil {
-		return result
-	}
+	return args.ValuesOptionMatch(flag, validOptions, OptionExact, desc)
+}
 
-	return nil
+// ValuesOptionMatch is ValuesOption matching the validOptions as selected by
+// the match modes (IE: OptionIgnoreCase | OptionPrefix).  The canonical option
+// matched is returned.
+func (args *Args) ValuesOptionMatch(
+	flag string, validOptions []string, match OptionMatch, desc string,
+) []string {
+	return Values(args, OptionParser(validOptions, match), flag, desc)
 }
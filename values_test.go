@@ -83,6 +83,46 @@ func TestSzargs_ValuesString_Success(t *testing.T) {
 	)
 }
 
+func TestSzargs_ValuesString_Attached(t *testing.T) {
+	chk := sztestlog.CaptureNothing(t)
+	defer chk.Release()
+
+	args := szargs.New("program description", []string{
+		"programName",
+		"-t1st",
+		"arg1",
+		"--test=second",
+		"-t",
+		"third",
+	})
+
+	result := args.ValuesString("[-t | --test value ...]", "the test flag")
+
+	chk.NoErr(args.Err())
+	chk.StrSlice(result, []string{"1st", "second", "third"})
+	chk.StrSlice(args.Args(), []string{"arg1"})
+}
+
+func TestSzargs_ValuesString_Separated(t *testing.T) {
+	chk := sztestlog.CaptureNothing(t)
+	defer chk.Release()
+
+	args := szargs.New("program description", []string{
+		"programName",
+		"-t", "a,b",
+		"-t", `"c,d"`,
+		"--test=e\\,f",
+	})
+
+	args.SetListSeparator(",")
+
+	result := args.ValuesString("[-t | --test value ...]", "the test flag")
+
+	chk.NoErr(args.Err())
+	chk.StrSlice(result, []string{"a", "b", "c,d", "e,f"})
+	chk.StrSlice(args.Args(), nil)
+}
+
 /*
  ***************************************************************************
  *
@@ -593,6 +633,40 @@ func TestSzargs_ValuesInt8_Success(t *testing.T) {
 	)
 }
 
+func TestSzargs_ValuesInt_Separated(t *testing.T) {
+	chk := sztestlog.CaptureNothing(t)
+	defer chk.Release()
+
+	args := szargs.New("program description", []string{
+		"programName",
+		"-n", "1",
+		"-n", "2,3",
+	})
+
+	args.SetListSeparator(",")
+
+	chk.IntSlice(args.ValuesInt("[-n num ...]", "the numbers"), []int{1, 2, 3})
+	chk.NoErr(args.Err())
+
+	args = szargs.New("program description", []string{
+		"programName",
+		"-n", "1,\"2",
+	})
+
+	args.SetListSeparator(",")
+
+	chk.IntSlice(args.ValuesInt("[-n num ...]", "the numbers"), nil)
+	chk.Err(
+		args.Err(),
+		chk.ErrChain(
+			szargs.ErrInvalidList,
+			szargs.ErrSyntax,
+			"[-n num ...]",
+			"'1,\"2'",
+		),
+	)
+}
+
 /*
  ***************************************************************************
  *
@@ -0,0 +1,188 @@
+/*
+   Szerszam argument library: szargs.
+   Copyright (C) 2024-2025  Leslie Dancsecs
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU General Public License for more details.
+
+   You should have received a copy of the GNU General Public License
+   along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package szargs
+
+import "fmt"
+
+// WalkFlag declares a flag recognized by Walk.
+type WalkFlag struct {
+	// Flag is the flag specification (IE: "[-i | --input file]").  The
+	// number of values the flag takes is the number of value names it
+	// declares.
+	Flag string
+	// Desc is the description registered for the usage message.
+	Desc string
+	// Anchor marks a flag to which following scoped flags attach.
+	Anchor bool
+	// Scoped marks a flag attaching to the most recent anchor flag.
+	Scoped bool
+}
+
+// Token is a single flag or positional argument produced by Walk.
+type Token struct {
+	// Flag is the specification of the matched flag or empty for a
+	// positional argument.
+	Flag string
+	// Arg is the argument as given on the command line.
+	Arg string
+	// Values holds the values taken by a flag.
+	Values []string
+	// Negated is true if a negatable flag was given in its negated form.
+	Negated bool
+	// Index is the position in the list passed to New (the program name
+	// being at zero) of the argument the token was taken from or -1 if it
+	// was not given there (IE: the argument was read from a response file,
+	// an environment variable or an alias expansion).
+	Index int
+	// Anchor is the position in the returned tokens of the anchor flag a
+	// scoped flag is attached to or -1 if there is none.
+	Anchor int
+}
+
+// IsFlag returns true if the token is a flag.
+func (t Token) IsFlag() bool {
+	return t.Flag != ""
+}
+
+// Walk absorbs all remaining arguments returning them in order as flags
+// (with their values) and positional arguments.  This preserves the
+// relationships lost when extracting arguments individually (IE: each
+// "--rate" in "-i a.mp4 --rate 30 -i b.mp4 --rate 60" belongs to the
+// preceding "-i").  A flag declared as Scoped records the most recent flag
+// declared as an Anchor.
+//
+// Every flag must be declared.  Any other flag registers an ErrUnexpected
+// error as does a flag followed by too few values an ErrMissing error.
+// Arguments following an end of options marker ("--") are always positional.
+// Short option groups are not expanded.
+func (args *Args) Walk(flags ...WalkFlag) []Token {
+	for _, flag := range flags {
+		args.RegisterUsage(flag.Flag, flag.Desc)
+	}
+
+	raw, origins := args.args, args.origins
+	terminated := args.terminated
+	anchor := -1
+
+	var tokens []Token
+
+	args.setArgs(nil, nil)
+
+	for i := 0; i < len(raw); i++ {
+		arg := raw[i]
+
+		if !terminated && arg == endOfOptions {
+			terminated = true
+
+			continue
+		}
+
+		name := args.dialectArg(arg, args.flags)
+
+		if terminated || !isFlagName(name) {
+			terminated = terminated || args.posixlyCorrect
+			tokens = append(tokens, Token{
+				Flag:    "",
+				Arg:     arg,
+				Values:  nil,
+				Negated: false,
+				Index:   origins[i].argv,
+				Anchor:  -1,
+			})
+
+			continue
+		}
+
+		decl, token, ok := matchWalkFlag(flags, name)
+		if !ok {
+			args.PushErr(fmt.Errorf("%w: '%s'", ErrUnexpected, arg))
+
+			continue
+		}
+
+		token.Arg = arg
+		token.Index = origins[i].argv
+
+		wanted := argFlag(decl.Flag).specValues()
+		for ; len(token.Values) < wanted && i+1 < len(raw); i++ {
+			token.Values = append(token.Values, raw[i+1])
+		}
+
+		if len(token.Values) < wanted {
+			args.PushErr(
+				fmt.Errorf(
+					"%w: '%s' expects %d values but found %d",
+					ErrMissing,
+					decl.Flag,
+					wanted,
+					len(token.Values),
+				),
+			)
+		}
+
+		if decl.Scoped {
+			token.Anchor = anchor
+		}
+
+		tokens = append(tokens, token)
+
+		if decl.Anchor {
+			anchor = len(tokens) - 1
+		}
+	}
+
+	return tokens
+}
+
+// matchWalkFlag returns the declared flag matching the argument along with a
+// token holding any attached value.
+func matchWalkFlag(flags []WalkFlag, arg string) (WalkFlag, Token, bool) {
+	token := Token{
+		Flag:    "",
+		Arg:     arg,
+		Values:  nil,
+		Negated: false,
+		Index:   -1,
+		Anchor:  -1,
+	}
+
+	for _, decl := range flags {
+		flag := argFlag(decl.Flag)
+		token.Flag = decl.Flag
+
+		switch {
+		case flag.argIs(arg):
+			return decl, token, true
+		case flag.argIsNegated(arg):
+			token.Negated = true
+
+			return decl, token, true
+		case flag.specValues() != noValue:
+			attached, ok := flag.argValue(arg)
+			if ok {
+				token.Values = []string{attached}
+
+				return decl, token, true
+			}
+		}
+	}
+
+	return WalkFlag{Flag: "", Desc: "", Anchor: false, Scoped: false}, token,
+		false
+}
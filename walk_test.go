@@ -0,0 +1,160 @@
+/*
+   Szerszam argument library: szargs.
+   Copyright (C) 2024-2025  Leslie Dancsecs
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU General Public License for more details.
+
+   You should have received a copy of the GNU General Public License
+   along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package szargs_test
+
+import (
+	"testing"
+
+	"github.com/dancsecs/szargs"
+	"github.com/dancsecs/sztestlog"
+)
+
+const (
+	tstWalkInput = "[-i | --input file]"
+	tstWalkRate  = "[--rate fps]"
+	tstWalkSize  = "[--size w h]"
+	tstWalkColor = "[--[no-]color]"
+)
+
+func tstWalkFlags() []szargs.WalkFlag {
+	return []szargs.WalkFlag{
+		{Flag: tstWalkInput, Desc: "an input", Anchor: true, Scoped: false},
+		{Flag: tstWalkRate, Desc: "the rate", Anchor: false, Scoped: true},
+		{Flag: tstWalkSize, Desc: "the size", Anchor: false, Scoped: true},
+		{Flag: tstWalkColor, Desc: "colorize", Anchor: false, Scoped: false},
+	}
+}
+
+func TestSzargs_Walk(t *testing.T) {
+	chk := sztestlog.CaptureNothing(t)
+	defer chk.Release()
+
+	args := szargs.New("program description", []string{
+		"programName",
+		"-v",
+		"--rate", "10",
+		"-i", "a.mp4",
+		"--rate=30",
+		"--no-color",
+		"--input", "b.mp4",
+		"--size", "640", "480",
+		"out.mp4",
+		"--",
+		"--rate",
+	})
+
+	chk.True(args.Is("[-v]", "verbose"))
+
+	tokens := args.Walk(tstWalkFlags()...)
+
+	chk.NoErr(args.Err())
+	chk.StrSlice(args.Args(), nil)
+	chk.Int(len(tokens), 8)
+
+	type expected struct {
+		flag    string
+		arg     string
+		values  []string
+		negated bool
+		index   int
+		anchor  int
+	}
+
+	for i, want := range []expected{
+		{tstWalkRate, "--rate", []string{"10"}, false, 2, -1},
+		{tstWalkInput, "-i", []string{"a.mp4"}, false, 4, -1},
+		{tstWalkRate, "--rate=30", []string{"30"}, false, 6, 1},
+		{tstWalkColor, "--no-color", nil, true, 7, -1},
+		{tstWalkInput, "--input", []string{"b.mp4"}, false, 8, -1},
+		{tstWalkSize, "--size", []string{"640", "480"}, false, 10, 4},
+		{"", "out.mp4", nil, false, 13, -1},
+		{"", "--rate", nil, false, 15, -1},
+	} {
+		chk.Str(tokens[i].Flag, want.flag)
+		chk.Str(tokens[i].Arg, want.arg)
+		chk.StrSlice(tokens[i].Values, want.values)
+		chk.Bool(tokens[i].Negated, want.negated)
+		chk.Int(tokens[i].Index, want.index)
+		chk.Int(tokens[i].Anchor, want.anchor)
+		chk.Bool(tokens[i].IsFlag(), want.flag != "")
+	}
+}
+
+func TestSzargs_Walk_Errors(t *testing.T) {
+	chk := sztestlog.CaptureNothing(t)
+	defer chk.Release()
+
+	args := szargs.New("program description", []string{
+		"programName",
+		"-x",
+		"-i", "a.mp4",
+		"--size", "640",
+	})
+
+	tokens := args.Walk(tstWalkFlags()...)
+
+	chk.Err(
+		args.Err(),
+		chk.ErrChain(
+			szargs.ErrUnexpected,
+			"'-x'",
+			szargs.ErrMissing,
+			"'"+tstWalkSize+"' expects 2 values but found 1",
+		),
+	)
+	chk.Int(len(tokens), 2)
+	chk.StrSlice(tokens[1].Values, []string{"640"})
+	chk.Int(tokens[1].Anchor, 0)
+}
+
+func TestSzargs_Walk_Prepended(t *testing.T) {
+	chk := sztestlog.CaptureNothing(t)
+	defer chk.Release()
+
+	chk.SetEnv(tstEnvArgs, "-i x")
+
+	args := szargs.New("program description", []string{
+		"programName",
+		"hd",
+		"-i", "x",
+		"a",
+	})
+
+	args.PrependEnvArgs(tstEnvArgs)
+	args.RegisterUsage(tstWalkInput, "an input")
+	args.ExpandAliases(map[string]string{"hd": "--size 1920 1080"})
+
+	tokens := args.Walk(tstWalkFlags()...)
+
+	chk.NoErr(args.Err())
+	chk.Int(len(tokens), 4)
+
+	for i, want := range []struct {
+		arg   string
+		index int
+	}{
+		{"-i", -1},
+		{"--size", -1},
+		{"-i", 2},
+		{"a", 4},
+	} {
+		chk.Str(tokens[i].Arg, want.arg)
+		chk.Int(tokens[i].Index, want.index)
+	}
+}